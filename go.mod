@@ -1,3 +1,3 @@
 module github.com/silentsokolov/go-vimeo/v2
 
-go 1.16
+go 1.18
@@ -0,0 +1,214 @@
+package vimeo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AnalyticsService handles communication with the analytics related
+// methods of the Vimeo API.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/analytics
+type AnalyticsService service
+
+// AnalyticsDimension is the dimension the analytics are grouped by.
+type AnalyticsDimension string
+
+// The dimensions of the analytics.
+const (
+	DimensionCountry     AnalyticsDimension = "country"
+	DimensionDeviceType  AnalyticsDimension = "device_type"
+	DimensionEmbedDomain AnalyticsDimension = "embed_domain"
+	DimensionVideo       AnalyticsDimension = "video"
+	DimensionTime        AnalyticsDimension = "time"
+)
+
+// AnalyticsInterval is the time granularity of the analytics.
+type AnalyticsInterval string
+
+// The time granularities of the analytics.
+const (
+	IntervalNone  AnalyticsInterval = "none"
+	IntervalDay   AnalyticsInterval = "day"
+	IntervalWeek  AnalyticsInterval = "week"
+	IntervalMonth AnalyticsInterval = "month"
+)
+
+// AnalyticsMetric is a value reported by the analytics.
+type AnalyticsMetric string
+
+// The metrics of the analytics.
+const (
+	MetricViews              AnalyticsMetric = "views"
+	MetricImpressions        AnalyticsMetric = "impressions"
+	MetricUniqueViewers      AnalyticsMetric = "unique_viewers"
+	MetricFinishes           AnalyticsMetric = "finishes"
+	MetricMeanPercentWatched AnalyticsMetric = "mean_percent_watched"
+)
+
+const analyticsDateLayout = "2006-01-02"
+
+// AnalyticsQuery describes an analytics report.
+type AnalyticsQuery struct {
+	Dimension AnalyticsDimension
+	// Interval is the time granularity, the API default when empty.
+	Interval AnalyticsInterval
+	// Start and End are the first and last days of the report.
+	Start time.Time
+	End   time.Time
+	// Metrics selects the reported metrics, all of them when empty.
+	Metrics []AnalyticsMetric
+	// Videos and Folders restrict the report to the given videos and folder URIs.
+	Videos  []int
+	Folders []string
+}
+
+type analyticsParam struct {
+	key   string
+	value string
+}
+
+func (o analyticsParam) Get() (string, string) {
+	return o.key, o.value
+}
+
+func (q *AnalyticsQuery) options() ([]CallOption, error) {
+	if q == nil {
+		return nil, errors.New("nil analytics query")
+	}
+	if q.Dimension == "" {
+		return nil, errors.New("analytics query without dimension")
+	}
+	if q.Start.IsZero() || q.End.IsZero() {
+		return nil, errors.New("analytics query without date range")
+	}
+	if q.End.Before(q.Start) {
+		return nil, fmt.Errorf("analytics query ends (%s) before it starts (%s)", q.End.Format(analyticsDateLayout), q.Start.Format(analyticsDateLayout))
+	}
+
+	opt := []CallOption{
+		analyticsParam{"dimension", string(q.Dimension)},
+		analyticsParam{"start_date", q.Start.Format(analyticsDateLayout)},
+		analyticsParam{"end_date", q.End.Format(analyticsDateLayout)},
+	}
+	if q.Interval != "" {
+		opt = append(opt, analyticsParam{"time_interval", string(q.Interval)})
+	}
+
+	if len(q.Metrics) > 0 {
+		fields := []string{"start_date", "end_date", string(q.Dimension)}
+		for _, m := range q.Metrics {
+			fields = append(fields, string(m))
+		}
+		opt = append(opt, OptFields(fields))
+	}
+
+	var content []string
+	for _, vid := range q.Videos {
+		content = append(content, fmt.Sprintf("/videos/%d", vid))
+	}
+	for _, f := range q.Folders {
+		content = append(content, "/"+strings.TrimPrefix(f, "/"))
+	}
+	if len(content) > 0 {
+		opt = append(opt, analyticsParam{"filter_content", strings.Join(content, ",")})
+	}
+
+	return opt, nil
+}
+
+// AnalyticsPoint represents a row of an analytics report: the metrics of one value
+// of the dimension over one time interval.
+type AnalyticsPoint struct {
+	StartDate time.Time
+	EndDate   time.Time
+
+	Country     string
+	DeviceType  string
+	EmbedDomain string
+	Video       *Video
+
+	Views              int
+	Impressions        int
+	UniqueViewers      int
+	Finishes           int
+	MeanPercentWatched float64
+}
+
+type analyticsPointJSON struct {
+	StartDate          string  `json:"start_date"`
+	EndDate            string  `json:"end_date"`
+	Country            string  `json:"country"`
+	DeviceType         string  `json:"device_type"`
+	EmbedDomain        string  `json:"embed_domain"`
+	Video              *Video  `json:"video"`
+	Views              int     `json:"views"`
+	Impressions        int     `json:"impressions"`
+	UniqueViewers      int     `json:"unique_viewers"`
+	Finishes           int     `json:"finishes"`
+	MeanPercentWatched float64 `json:"mean_percent_watched"`
+}
+
+// UnmarshalJSON decodes a row of the report, the dates are either days or RFC 3339 timestamps.
+func (p *AnalyticsPoint) UnmarshalJSON(b []byte) error {
+	var raw analyticsPointJSON
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	start, err := parseAnalyticsDate(raw.StartDate)
+	if err != nil {
+		return err
+	}
+	end, err := parseAnalyticsDate(raw.EndDate)
+	if err != nil {
+		return err
+	}
+
+	*p = AnalyticsPoint{
+		StartDate:          start,
+		EndDate:            end,
+		Country:            raw.Country,
+		DeviceType:         raw.DeviceType,
+		EmbedDomain:        raw.EmbedDomain,
+		Video:              raw.Video,
+		Views:              raw.Views,
+		Impressions:        raw.Impressions,
+		UniqueViewers:      raw.UniqueViewers,
+		Finishes:           raw.Finishes,
+		MeanPercentWatched: raw.MeanPercentWatched,
+	}
+	return nil
+}
+
+func parseAnalyticsDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(analyticsDateLayout, s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// List method returns the analytics report of the user, one point per value of the
+// dimension and time interval. The report is paginated like other lists.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/analytics#get_analytics
+func (s *AnalyticsService) List(uid string, q *AnalyticsQuery, opt ...CallOption) ([]*AnalyticsPoint, *Response, error) {
+	qopt, err := q.options()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := "me/analytics"
+	if uid != "" {
+		u = fmt.Sprintf("users/%s/analytics", uid)
+	}
+
+	return List[AnalyticsPoint](s.client, u, append(qopt, opt...)...)
+}
@@ -0,0 +1,71 @@
+package vimeo
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAnalyticsService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/analytics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"dimension":      "country",
+			"start_date":     "2024-01-01",
+			"end_date":       "2024-01-31",
+			"time_interval":  "week",
+			"fields":         "start_date,end_date,country,views,finishes",
+			"filter_content": "/videos/1,/users/1/projects/2",
+			"page":           "2",
+		})
+		fmt.Fprint(w, `{"data": [{"start_date": "2024-01-01", "end_date": "2024-01-07T00:00:00Z", "country": "FR", "views": 10, "finishes": 4, "mean_percent_watched": 52.5}]}`)
+	})
+
+	points, _, err := client.Analytics.List("1", &AnalyticsQuery{
+		Dimension: DimensionCountry,
+		Interval:  IntervalWeek,
+		Start:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:       time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		Metrics:   []AnalyticsMetric{MetricViews, MetricFinishes},
+		Videos:    []int{1},
+		Folders:   []string{"users/1/projects/2"},
+	}, OptPage(2))
+	if err != nil {
+		t.Fatalf("Analytics.List returned unexpected error: %v", err)
+	}
+
+	want := []*AnalyticsPoint{{
+		StartDate:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:            time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+		Country:            "FR",
+		Views:              10,
+		Finishes:           4,
+		MeanPercentWatched: 52.5,
+	}}
+	if !reflect.DeepEqual(points, want) {
+		t.Errorf("Analytics.List returned %+v, want %+v", points, want)
+	}
+}
+
+func TestAnalyticsService_List_invalidQuery(t *testing.T) {
+	setup()
+	defer teardown()
+
+	start := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	for _, q := range []*AnalyticsQuery{
+		nil,
+		{Start: start, End: start},
+		{Dimension: DimensionTime},
+		{Dimension: DimensionTime, Start: start, End: start.AddDate(0, 0, -1)},
+	} {
+		if _, _, err := client.Analytics.List("", q); err == nil {
+			t.Errorf("Analytics.List(%+v) expected error", q)
+		}
+	}
+}
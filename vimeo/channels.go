@@ -12,61 +12,43 @@ import (
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels
 type ChannelsService service
 
-type dataListChannel struct {
-	Data []*Channel `json:"data"`
-	pagination
-}
-
 // Channel represents a channel.
 type Channel struct {
-	URI          string    `json:"uri,omitempty"`
-	Name         string    `json:"name,omitempty"`
-	Description  string    `json:"description,omitempty"`
-	Link         string    `json:"link,omitempty"`
-	CreatedTime  time.Time `json:"created_time,omitempty"`
-	ModifiedTime time.Time `json:"modified_time,omitempty"`
-	User         *User     `json:"user,omitempty"`
-	Pictures     *Pictures `json:"pictures,omitempty"`
-	Header       *Header   `json:"header,omitempty"`
-	Privacy      *Privacy  `json:"privacy,omitempty"`
-	ResourceKey  string    `json:"resource_key,omitempty"`
+	URI          string          `json:"uri,omitempty"`
+	Name         string          `json:"name,omitempty"`
+	Description  string          `json:"description,omitempty"`
+	Link         string          `json:"link,omitempty"`
+	CreatedTime  time.Time       `json:"created_time,omitempty"`
+	ModifiedTime time.Time       `json:"modified_time,omitempty"`
+	User         *User           `json:"user,omitempty"`
+	Pictures     *Pictures       `json:"pictures,omitempty"`
+	Header       *Header         `json:"header,omitempty"`
+	Privacy      *ChannelPrivacy `json:"privacy,omitempty"`
+	Metadata     *Metadata       `json:"metadata,omitempty"`
+	ResourceKey  string          `json:"resource_key,omitempty"`
 }
 
 // ChannelRequest represents a request to create/edit an channel.
+// Nil fields are left out of the request.
 type ChannelRequest struct {
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
-	Privacy     string `json:"privacy,omitempty"`
+	Name        *string      `json:"name,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	Privacy     *ChannelView `json:"privacy,omitempty"`
 }
 
 // GetID returns the identifier (ID) of the channel.
 func (c Channel) GetID() string {
+	if ref, err := ParseRef(c.URI); err == nil && ref.Kind == RefChannel {
+		return ref.ID
+	}
+
 	l := strings.SplitN(c.URI, "/", -1)
 	id := l[len(l)-1]
 	return id
 }
 
 func listChannel(c *Client, url string, opt ...CallOption) ([]*Channel, *Response, error) {
-	u, err := addOptions(url, opt...)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	req, err := c.NewRequest("GET", u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	channels := &dataListChannel{}
-
-	resp, err := c.Do(req, channels)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	resp.setPaging(channels)
-
-	return channels.Data, resp, err
+	return List[Channel](c, url, opt...)
 }
 
 // List method gets all existing channels.
@@ -82,6 +64,10 @@ func (s *ChannelsService) List(opt ...CallOption) ([]*Channel, *Response, error)
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#create_channel
 func (s *ChannelsService) Create(r *ChannelRequest) (*Channel, *Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest("POST", "channels", r)
 	if err != nil {
 		return nil, nil, err
@@ -124,6 +110,10 @@ func (s *ChannelsService) Get(ch string, opt ...CallOption) (*Channel, *Response
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/channels#edit_channel
 func (s *ChannelsService) Edit(ch string, r *ChannelRequest) (*Channel, *Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("channels/%s", ch)
 	req, err := s.client.NewRequest("PATCH", u, r)
 	if err != nil {
@@ -44,11 +44,7 @@ func TestChannelsService_Create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	input := &ChannelRequest{
-		Name:        "name",
-		Description: "desc",
-		Privacy:     "anybody",
-	}
+	input := (&ChannelRequest{}).SetName("name").SetDescription("desc").SetPrivacy(ChannelAnybody)
 
 	mux.HandleFunc("/channels", func(w http.ResponseWriter, r *http.Request) {
 		v := &ChannelRequest{}
@@ -103,11 +99,7 @@ func TestChannelsService_Edit(t *testing.T) {
 	setup()
 	defer teardown()
 
-	input := &ChannelRequest{
-		Name:        "name",
-		Description: "desc",
-		Privacy:     "anybody",
-	}
+	input := (&ChannelRequest{}).SetName("name").SetDescription("desc").SetPrivacy(ChannelAnybody)
 
 	mux.HandleFunc("/channels/1", func(w http.ResponseWriter, r *http.Request) {
 		v := &ChannelRequest{}
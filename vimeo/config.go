@@ -1,14 +1,28 @@
 package vimeo
 
+const defaultConcurrency = 4
+
 // Config provides a way to configure the Client depending on your needs.
 type Config struct {
 	// Uploader
 	Uploader Uploader
+
+	// Concurrency limits the number of requests sent at the same time
+	// by the methods that fan out, such as VideosService.GetMany.
+	Concurrency int
 }
 
 // DefaultConfig return the default Client configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		Uploader: nil,
+		Uploader:    nil,
+		Concurrency: defaultConcurrency,
+	}
+}
+
+func (c *Config) concurrency() int {
+	if c == nil || c.Concurrency < 1 {
+		return defaultConcurrency
 	}
+	return c.Concurrency
 }
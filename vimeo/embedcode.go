@@ -0,0 +1,252 @@
+package vimeo
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultPlayerURL = "https://player.vimeo.com/video/"
+
+// PlayerOptions represents the parameters of the embeddable player.
+// Nil fields and empty strings are left out, so the player uses its defaults.
+type PlayerOptions struct {
+	Autoplay    *bool
+	Autopause   *bool
+	Loop        *bool
+	Muted       *bool
+	Title       *bool
+	Byline      *bool
+	Portrait    *bool
+	DNT         *bool
+	PlaysInline *bool
+	Background  *bool
+	Controls    *bool
+	PIP         *bool
+	Keyboard    *bool
+	// Color is the hexadecimal color of the player controls, with or without "#".
+	Color string
+	// TextTrack is the language code of the text track shown by default.
+	TextTrack string
+	// Quality is the playback quality, for example "720p".
+	Quality string
+	// Start is the playback start time, rounded down to seconds.
+	Start time.Duration
+}
+
+// PlayerOptionsFromSettings returns the player options matching the embed settings of a video or preset.
+func PlayerOptionsFromSettings(s *EmbedSettings) *PlayerOptions {
+	o := &PlayerOptions{}
+	if s == nil {
+		return o
+	}
+
+	o.Autoplay = s.Autoplay
+	o.Autopause = s.Autopause
+	o.Loop = s.Loop
+	o.Title = showHide(s.Title)
+	o.Byline = showHide(s.ByLine)
+	o.Portrait = showHide(s.Portrait)
+	if s.Color != nil {
+		o.Color = *s.Color
+	}
+
+	return o
+}
+
+func showHide(s *string) *bool {
+	if s == nil {
+		return nil
+	}
+	switch *s {
+	case "show":
+		return Bool(true)
+	case "hide":
+		return Bool(false)
+	}
+	return nil
+}
+
+// EmbedCode represents the settings of an iframe embed code.
+type EmbedCode struct {
+	// Width and Height of the player, 640x360 when zero.
+	// For a responsive player they only set the aspect ratio.
+	Width  int
+	Height int
+	// Title is the title attribute of the iframe, for accessibility.
+	Title string
+	// Responsive wraps the player in a container that keeps the aspect ratio at any width.
+	Responsive bool
+	// Lazy defers loading the player until it is near the viewport.
+	Lazy bool
+	// Allow is the permissions policy of the iframe,
+	// "autoplay; fullscreen; picture-in-picture" when nil.
+	Allow []string
+	// Sandbox restricts the iframe, nil means no sandbox attribute.
+	Sandbox []string
+	Options *PlayerOptions
+}
+
+var defaultAllow = []string{"autoplay", "fullscreen", "picture-in-picture"}
+
+// PlayerURL returns the player.vimeo.com link of a video with the player options applied.
+// The video can be anything ParseVideoID understands, the unlisted hash is kept.
+func PlayerURL(video string, opts *PlayerOptions) (string, error) {
+	id, err := ParseVideoID(video)
+	if err != nil {
+		return "", err
+	}
+	return id.PlayerURL(opts), nil
+}
+
+// PlayerURL returns the player.vimeo.com link of the video with the player options applied.
+// The unlisted hash is passed as the h parameter.
+func (id VideoID) PlayerURL(opts *PlayerOptions) string {
+	qs := url.Values{}
+	if id.Hash != "" {
+		qs.Set("h", id.Hash)
+	}
+
+	var fragment string
+	if opts != nil {
+		for _, p := range []struct {
+			key string
+			v   *bool
+		}{
+			{"autoplay", opts.Autoplay},
+			{"autopause", opts.Autopause},
+			{"loop", opts.Loop},
+			{"muted", opts.Muted},
+			{"title", opts.Title},
+			{"byline", opts.Byline},
+			{"portrait", opts.Portrait},
+			{"dnt", opts.DNT},
+			{"playsinline", opts.PlaysInline},
+			{"background", opts.Background},
+			{"controls", opts.Controls},
+			{"pip", opts.PIP},
+			{"keyboard", opts.Keyboard},
+		} {
+			if p.v == nil {
+				continue
+			}
+			if *p.v {
+				qs.Set(p.key, "1")
+			} else {
+				qs.Set(p.key, "0")
+			}
+		}
+
+		if c := strings.TrimPrefix(opts.Color, "#"); c != "" {
+			qs.Set("color", c)
+		}
+		if opts.TextTrack != "" {
+			qs.Set("texttrack", opts.TextTrack)
+		}
+		if opts.Quality != "" {
+			qs.Set("quality", opts.Quality)
+		}
+		if s := int(opts.Start / time.Second); s > 0 {
+			fragment = fmt.Sprintf("#t=%ds", s)
+		}
+	}
+
+	u := defaultPlayerURL + strconv.Itoa(id.ID)
+	if len(qs) > 0 {
+		u += "?" + qs.Encode()
+	}
+	return u + fragment
+}
+
+// EmbedHTML returns the iframe embed code of a video.
+// The video can be anything ParseVideoID understands, the unlisted hash is kept.
+// The output is escaped and its attributes always come in the same order.
+func EmbedHTML(video string, e *EmbedCode) (string, error) {
+	id, err := ParseVideoID(video)
+	if err != nil {
+		return "", err
+	}
+	return id.EmbedHTML(e), nil
+}
+
+// EmbedHTML method returns the iframe embed code of the video. The video name is
+// the default iframe title and, when e.Options is nil, the player options come from the embed settings of the video preset.
+func (v Video) EmbedHTML(e *EmbedCode) (string, error) {
+	id := v.GetVideoID()
+	if id.ID == 0 {
+		return "", fmt.Errorf("video %q has no ID", v.URI)
+	}
+
+	c := EmbedCode{}
+	if e != nil {
+		c = *e
+	}
+	if c.Title == "" {
+		c.Title = v.Name
+	}
+	if c.Options == nil && v.EmbedPresets != nil {
+		c.Options = PlayerOptionsFromSettings(v.EmbedPresets.Settings)
+	}
+
+	return id.EmbedHTML(&c), nil
+}
+
+// EmbedHTML returns the iframe embed code of the video, with the unlisted hash in the player link.
+func (id VideoID) EmbedHTML(e *EmbedCode) string {
+	if e == nil {
+		e = &EmbedCode{}
+	}
+
+	width, height := e.Width, e.Height
+	if width <= 0 || height <= 0 {
+		width, height = 640, 360
+	}
+
+	allow := e.Allow
+	if allow == nil {
+		allow = defaultAllow
+	}
+
+	var b strings.Builder
+	attr := func(name, value string) {
+		fmt.Fprintf(&b, ` %s="%s"`, name, html.EscapeString(value))
+	}
+
+	if e.Responsive {
+		ratio := math.Round(float64(height)/float64(width)*1000000) / 10000
+		fmt.Fprintf(&b, `<div style="padding:%s%% 0 0 0;position:relative;">`, strconv.FormatFloat(ratio, 'f', -1, 64))
+	}
+
+	b.WriteString("<iframe")
+	attr("src", id.PlayerURL(e.Options))
+	if e.Responsive {
+		attr("style", "position:absolute;top:0;left:0;width:100%;height:100%;")
+	} else {
+		attr("width", strconv.Itoa(width))
+		attr("height", strconv.Itoa(height))
+	}
+	attr("frameborder", "0")
+	if len(allow) > 0 {
+		attr("allow", strings.Join(allow, "; "))
+	}
+	if e.Sandbox != nil {
+		attr("sandbox", strings.Join(e.Sandbox, " "))
+	}
+	if e.Lazy {
+		attr("loading", "lazy")
+	}
+	if e.Title != "" {
+		attr("title", e.Title)
+	}
+	b.WriteString(" allowfullscreen></iframe>")
+
+	if e.Responsive {
+		b.WriteString("</div>")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,112 @@
+package vimeo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlayerURL(t *testing.T) {
+	opts := &PlayerOptions{
+		Autoplay:  Bool(true),
+		Muted:     Bool(true),
+		Title:     Bool(false),
+		Color:     "#00ADEF",
+		TextTrack: "en",
+		Start:     90*time.Second + 500*time.Millisecond,
+	}
+
+	got, err := PlayerURL("https://vimeo.com/76979871/abcdef", opts)
+	if err != nil {
+		t.Fatalf("PlayerURL returned unexpected error: %v", err)
+	}
+
+	want := "https://player.vimeo.com/video/76979871?autoplay=1&color=00ADEF&h=abcdef&muted=1&texttrack=en&title=0#t=90s"
+	if got != want {
+		t.Errorf("PlayerURL returned %v, want %v", got, want)
+	}
+}
+
+func TestEmbedHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		e    *EmbedCode
+		want string
+	}{
+		{
+			"default",
+			nil,
+			`<iframe src="https://player.vimeo.com/video/1" width="640" height="360" frameborder="0" allow="autoplay; fullscreen; picture-in-picture" allowfullscreen></iframe>`,
+		},
+		{
+			"escaped",
+			&EmbedCode{Width: 320, Height: 240, Title: `"Tom & Jerry" <3`, Lazy: true, Sandbox: []string{"allow-scripts", "allow-same-origin"}, Allow: []string{}},
+			`<iframe src="https://player.vimeo.com/video/1" width="320" height="240" frameborder="0" sandbox="allow-scripts allow-same-origin" loading="lazy" title="&#34;Tom &amp; Jerry&#34; &lt;3" allowfullscreen></iframe>`,
+		},
+		{
+			"responsive",
+			&EmbedCode{Width: 1920, Height: 800, Responsive: true, Options: &PlayerOptions{Loop: Bool(true), DNT: Bool(true)}},
+			`<div style="padding:41.6667% 0 0 0;position:relative;"><iframe src="https://player.vimeo.com/video/1?dnt=1&amp;loop=1" style="position:absolute;top:0;left:0;width:100%;height:100%;" frameborder="0" allow="autoplay; fullscreen; picture-in-picture" allowfullscreen></iframe></div>`,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := EmbedHTML("1", tt.e)
+		if err != nil {
+			t.Fatalf("EmbedHTML(%s) returned unexpected error: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("EmbedHTML(%s) returned\n%v\nwant\n%v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestVideo_EmbedHTML(t *testing.T) {
+	v := Video{
+		URI:  "/videos/1:abcdef",
+		Name: "Test",
+		EmbedPresets: &EmbedPresets{
+			Settings: &EmbedSettings{Autoplay: Bool(true), ByLine: String("hide"), Color: String("ff0000")},
+		},
+	}
+
+	got, err := v.EmbedHTML(nil)
+	if err != nil {
+		t.Fatalf("Video.EmbedHTML returned unexpected error: %v", err)
+	}
+
+	want := `<iframe src="https://player.vimeo.com/video/1?autoplay=1&amp;byline=0&amp;color=ff0000&amp;h=abcdef" width="640" height="360" frameborder="0" allow="autoplay; fullscreen; picture-in-picture" title="Test" allowfullscreen></iframe>`
+	if got != want {
+		t.Errorf("Video.EmbedHTML returned\n%v\nwant\n%v", got, want)
+	}
+
+	if _, err := (Video{}).EmbedHTML(nil); err == nil {
+		t.Error("Video.EmbedHTML expected error for a video without URI")
+	}
+}
+
+func TestVideoID_PlayerURL(t *testing.T) {
+	id := VideoID{ID: 1, Hash: "abcdef"}
+
+	got := id.PlayerURL(&PlayerOptions{Autoplay: Bool(true)})
+	want := "https://player.vimeo.com/video/1?autoplay=1&h=abcdef"
+	if got != want {
+		t.Errorf("VideoID.PlayerURL returned %v, want %v", got, want)
+	}
+
+	if got, want := (VideoID{ID: 1}).PlayerURL(nil), "https://player.vimeo.com/video/1"; got != want {
+		t.Errorf("VideoID.PlayerURL returned %v, want %v", got, want)
+	}
+}
+
+func TestVideoID_EmbedHTML(t *testing.T) {
+	id, err := ParseVideoID("1:abcdef")
+	if err != nil {
+		t.Fatalf("ParseVideoID returned unexpected error: %v", err)
+	}
+
+	got := id.EmbedHTML(&EmbedCode{Allow: []string{}})
+	want := `<iframe src="https://player.vimeo.com/video/1?h=abcdef" width="640" height="360" frameborder="0" allowfullscreen></iframe>`
+	if got != want {
+		t.Errorf("VideoID.EmbedHTML returned %v, want %v", got, want)
+	}
+}
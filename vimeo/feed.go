@@ -0,0 +1,493 @@
+package vimeo
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	mediaRSSXMLNS = "http://search.yahoo.com/mrss/"
+	atomXMLNS     = "http://www.w3.org/2005/Atom"
+)
+
+// FeedFormat is the format of a video feed.
+type FeedFormat string
+
+// The feed formats.
+const (
+	FeedRSS  FeedFormat = "rss"
+	FeedAtom FeedFormat = "atom"
+)
+
+// VideoFeed represents a video feed, written as RSS 2.0 with Media RSS extensions or as Atom.
+type VideoFeed struct {
+	Title       string
+	Link        string
+	Description string
+	Language    string
+	// Updated is the last change of the feed, the latest video modification when zero.
+	Updated time.Time
+	Videos  []*Video
+	// Credits maps a video URI to its credits, see VideosService.LoadCredits.
+	Credits map[string][]*Credit
+}
+
+// NewVideoFeed returns a feed of every video of the iterator, for example over
+// ChannelsService.ListVideo, UsersService.AlbumListVideo or GroupsService.ListVideo.
+func NewVideoFeed(title, link string, it *Iterator[Video]) (*VideoFeed, error) {
+	videos, err := it.All()
+	if err != nil {
+		return nil, err
+	}
+	return &VideoFeed{Title: title, Link: link, Videos: videos}, nil
+}
+
+// LoadCredits method requests every credit of every video of the feed,
+// up to Config.Concurrency videos at a time.
+func (s *VideosService) LoadCredits(f *VideoFeed) error {
+	credits := make([][]*Credit, len(f.Videos))
+	errs := make([]error, len(f.Videos))
+	sem := make(chan struct{}, s.client.Config.concurrency())
+
+	var wg sync.WaitGroup
+	for i, v := range f.Videos {
+		id := v.GetID()
+		if id == 0 {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i, id int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			credits[i], errs[i] = NewIterator(func(opt ...CallOption) ([]*Credit, *Response, error) {
+				return s.ListCredit(id, opt...)
+			}, OptPerPage(maxBatchSize)).All()
+		}(i, id)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	if f.Credits == nil {
+		f.Credits = make(map[string][]*Credit, len(f.Videos))
+	}
+	for i, v := range f.Videos {
+		if credits[i] != nil {
+			f.Credits[v.URI] = credits[i]
+		}
+	}
+
+	return nil
+}
+
+func (f *VideoFeed) updated() time.Time {
+	if !f.Updated.IsZero() {
+		return f.Updated
+	}
+	var last time.Time
+	for _, v := range f.Videos {
+		if v.ModifiedTime.After(last) {
+			last = v.ModifiedTime
+		}
+	}
+	return last
+}
+
+type mediaThumbnail struct {
+	URL    string `xml:"url,attr"`
+	Width  int    `xml:"width,attr,omitempty"`
+	Height int    `xml:"height,attr,omitempty"`
+}
+
+type mediaCredit struct {
+	Role   string `xml:"role,attr,omitempty"`
+	Scheme string `xml:"scheme,attr,omitempty"`
+	Name   string `xml:",chardata"`
+}
+
+type mediaPlayer struct {
+	URL    string `xml:"url,attr"`
+	Width  int    `xml:"width,attr,omitempty"`
+	Height int    `xml:"height,attr,omitempty"`
+}
+
+// mediaElements holds the Media RSS elements shared by RSS items and Atom entries.
+type mediaElements struct {
+	Player      *mediaPlayer      `xml:"media:player,omitempty"`
+	Title       string            `xml:"media:title,omitempty"`
+	Description string            `xml:"media:description,omitempty"`
+	Keywords    string            `xml:"media:keywords,omitempty"`
+	Thumbnails  []*mediaThumbnail `xml:"media:thumbnail"`
+	Credits     []*mediaCredit    `xml:"media:credit"`
+}
+
+func (f *VideoFeed) media(v *Video) mediaElements {
+	m := mediaElements{
+		Title:       v.Name,
+		Description: v.Description,
+		Keywords:    strings.Join(sitemapTags(v), ", "),
+	}
+
+	// The player is an HTML page, not a media file, so there is no media:content.
+	if id := v.GetVideoID(); id.ID != 0 && embeddable(v) {
+		m.Player = &mediaPlayer{URL: id.PlayerURL(nil), Width: v.Width, Height: v.Height}
+	}
+
+	if v.Pictures != nil {
+		for _, size := range v.Pictures.Sizes {
+			m.Thumbnails = append(m.Thumbnails, &mediaThumbnail{URL: size.Link, Width: size.Width, Height: size.Height})
+		}
+	}
+
+	for _, c := range f.Credits[v.URI] {
+		name := c.Name
+		if name == "" && c.User != nil {
+			name = c.User.Name
+		}
+		m.Credits = append(m.Credits, &mediaCredit{Role: strings.ToLower(c.Role), Scheme: "urn:ebu", Name: name})
+	}
+
+	return m
+}
+
+func videoCategories(v *Video) []string {
+	var names []string
+	for _, c := range v.Categories {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+type rss struct {
+	XMLName    xml.Name    `xml:"rss"`
+	Version    string      `xml:"version,attr"`
+	XMLNSMedia string      `xml:"xmlns:media,attr"`
+	Channel    *rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string     `xml:"title"`
+	Link          string     `xml:"link"`
+	Description   string     `xml:"description"`
+	Language      string     `xml:"language,omitempty"`
+	LastBuildDate string     `xml:"lastBuildDate,omitempty"`
+	Generator     string     `xml:"generator"`
+	Items         []*rssItem `xml:"item"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        *rssGUID `xml:"guid"`
+	Description string   `xml:"description,omitempty"`
+	PubDate     string   `xml:"pubDate,omitempty"`
+	Categories  []string `xml:"category"`
+	mediaElements
+}
+
+// WriteRSS writes the feed to w as RSS 2.0 with Media RSS extensions.
+//
+// Media RSS docs: https://www.rssboard.org/media-rss
+func (f *VideoFeed) WriteRSS(w io.Writer) error {
+	ch := &rssChannel{
+		Title:       f.Title,
+		Link:        f.Link,
+		Description: f.Description,
+		Language:    f.Language,
+		Generator:   defaultUserAgent,
+	}
+	if ch.Description == "" {
+		ch.Description = f.Title
+	}
+	if t := f.updated(); !t.IsZero() {
+		ch.LastBuildDate = t.Format(time.RFC1123Z)
+	}
+
+	for _, v := range f.Videos {
+		item := &rssItem{
+			Title:         v.Name,
+			Link:          v.Link,
+			GUID:          &rssGUID{IsPermaLink: true, Value: v.Link},
+			Description:   v.Description,
+			Categories:    videoCategories(v),
+			mediaElements: f.media(v),
+		}
+		if t := sitemapPublished(v); !t.IsZero() {
+			item.PubDate = t.Format(time.RFC1123Z)
+		}
+		ch.Items = append(ch.Items, item)
+	}
+
+	return writeXML(w, &rss{Version: "2.0", XMLNSMedia: mediaRSSXMLNS, Channel: ch})
+}
+
+type atomFeed struct {
+	XMLName    xml.Name     `xml:"feed"`
+	XMLNS      string       `xml:"xmlns,attr"`
+	XMLNSMedia string       `xml:"xmlns:media,attr"`
+	ID         string       `xml:"id"`
+	Title      string       `xml:"title"`
+	Subtitle   string       `xml:"subtitle,omitempty"`
+	Updated    string       `xml:"updated"`
+	Links      []*atomLink  `xml:"link"`
+	Generator  string       `xml:"generator"`
+	Entries    []*atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomEntry struct {
+	ID         string          `xml:"id"`
+	Title      string          `xml:"title"`
+	Updated    string          `xml:"updated"`
+	Published  string          `xml:"published,omitempty"`
+	Links      []*atomLink     `xml:"link"`
+	Summary    string          `xml:"summary,omitempty"`
+	Author     *atomAuthor     `xml:"author,omitempty"`
+	Categories []*atomCategory `xml:"category"`
+	mediaElements
+}
+
+// WriteAtom writes the feed to w as Atom with Media RSS extensions.
+// Atom requires an updated date, the current time is used when the feed has none.
+func (f *VideoFeed) WriteAtom(w io.Writer) error {
+	updated := f.updated()
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	feed := &atomFeed{
+		XMLNS:      atomXMLNS,
+		XMLNSMedia: mediaRSSXMLNS,
+		ID:         f.Link,
+		Title:      f.Title,
+		Subtitle:   f.Description,
+		Updated:    updated.Format(time.RFC3339),
+		Links:      []*atomLink{{Rel: "alternate", Href: f.Link}},
+		Generator:  defaultUserAgent,
+	}
+
+	for _, v := range f.Videos {
+		modified := v.ModifiedTime
+		if modified.IsZero() {
+			modified = sitemapPublished(v)
+		}
+		if modified.IsZero() {
+			modified = updated
+		}
+		e := &atomEntry{
+			ID:            v.Link,
+			Title:         v.Name,
+			Updated:       modified.Format(time.RFC3339),
+			Links:         []*atomLink{{Rel: "alternate", Href: v.Link}},
+			Summary:       v.Description,
+			mediaElements: f.media(v),
+		}
+		if t := sitemapPublished(v); !t.IsZero() {
+			e.Published = t.Format(time.RFC3339)
+		}
+		if v.User != nil && v.User.Name != "" {
+			e.Author = &atomAuthor{Name: v.User.Name, URI: v.User.Link}
+		}
+		for _, c := range videoCategories(v) {
+			e.Categories = append(e.Categories, &atomCategory{Term: c})
+		}
+		feed.Entries = append(feed.Entries, e)
+	}
+
+	return writeXML(w, feed)
+}
+
+// defaultFeedEntries is the number of feeds a FeedHandler keeps when MaxEntries is zero.
+const defaultFeedEntries = 64
+
+// FeedHandler is an http.Handler that serves a video feed. The feed is built
+// by Load at most once per MaxAge for each key, and served with
+// Cache-Control, Last-Modified and ETag headers so clients can revalidate.
+// Concurrent requests for a key that is being loaded wait for that load.
+type FeedHandler struct {
+	Load   func(r *http.Request) (*VideoFeed, error)
+	Format FeedFormat
+	MaxAge time.Duration
+	// Key returns the cache key of a request. When nil, the key is the URL path,
+	// so the query string can't create new feeds. Load sees the first request of a key.
+	Key func(r *http.Request) string
+	// MaxEntries bounds the number of cached feeds, 64 when zero.
+	// Expired feeds are evicted first, then the ones closest to expiring.
+	MaxEntries int
+
+	mu      sync.Mutex
+	cache   map[string]*cachedFeed
+	loading map[string]*feedLoad
+}
+
+type cachedFeed struct {
+	body     []byte
+	etag     string
+	modified time.Time
+	expires  time.Time
+}
+
+// errFeedLoadPanic is returned to the requests waiting for a load that panicked.
+var errFeedLoadPanic = errors.New("feed load panicked")
+
+// feedLoad is a load in flight, shared by the requests of a key.
+type feedLoad struct {
+	done chan struct{}
+	feed *cachedFeed
+	err  error
+}
+
+// ServeHTTP serves the cached feed of the request key, loading it when it's missing or expired.
+// It replies 502 Bad Gateway when the feed can't be loaded.
+func (h *FeedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	feed, err := h.get(r)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+
+	if h.Format == FeedAtom {
+		w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	} else {
+		w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+	}
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.MaxAge/time.Second)))
+	w.Header().Set("ETag", feed.etag)
+
+	// ServeContent handles If-None-Match, If-Modified-Since and HEAD requests.
+	http.ServeContent(w, r, "", feed.modified, bytes.NewReader(feed.body))
+}
+
+func (h *FeedHandler) get(r *http.Request) (*cachedFeed, error) {
+	key := r.URL.Path
+	if h.Key != nil {
+		key = h.Key(r)
+	}
+
+	h.mu.Lock()
+	if c, ok := h.cache[key]; ok && time.Now().Before(c.expires) {
+		h.mu.Unlock()
+		return c, nil
+	}
+	if l, ok := h.loading[key]; ok {
+		h.mu.Unlock()
+		<-l.done
+		return l.feed, l.err
+	}
+	l := &feedLoad{done: make(chan struct{})}
+	if h.loading == nil {
+		h.loading = make(map[string]*feedLoad)
+	}
+	h.loading[key] = l
+	h.mu.Unlock()
+
+	// The load is released even when Load panics, and its waiters get errFeedLoadPanic.
+	defer func() {
+		h.mu.Lock()
+		delete(h.loading, key)
+		if l.err == nil {
+			h.store(key, l.feed)
+		}
+		h.mu.Unlock()
+		close(l.done)
+	}()
+
+	l.err = errFeedLoadPanic
+	l.feed, l.err = h.load(r)
+
+	return l.feed, l.err
+}
+
+func (h *FeedHandler) load(r *http.Request) (*cachedFeed, error) {
+	feed, err := h.Load(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if h.Format == FeedAtom {
+		err = feed.WriteAtom(&buf)
+	} else {
+		err = feed.WriteRSS(&buf)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha1.Sum(buf.Bytes())
+	return &cachedFeed{
+		body:     buf.Bytes(),
+		etag:     `"` + hex.EncodeToString(sum[:]) + `"`,
+		modified: feed.updated(),
+		expires:  time.Now().Add(h.MaxAge),
+	}, nil
+}
+
+// store caches c under key, evicting feeds beyond MaxEntries. It's called with h.mu held.
+func (h *FeedHandler) store(key string, c *cachedFeed) {
+	if h.cache == nil {
+		h.cache = make(map[string]*cachedFeed)
+	}
+	limit := h.MaxEntries
+	if limit < 1 {
+		limit = defaultFeedEntries
+	}
+
+	if _, ok := h.cache[key]; !ok && len(h.cache) >= limit {
+		now := time.Now()
+		for k, v := range h.cache {
+			if !now.Before(v.expires) {
+				delete(h.cache, k)
+			}
+		}
+		for len(h.cache) >= limit {
+			var oldest string
+			var expires time.Time
+			for k, v := range h.cache {
+				if expires.IsZero() || v.expires.Before(expires) {
+					oldest, expires = k, v.expires
+				}
+			}
+			delete(h.cache, oldest)
+		}
+	}
+
+	h.cache[key] = c
+}
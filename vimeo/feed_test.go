@@ -0,0 +1,306 @@
+package vimeo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func feedTestVideo(id int) *Video {
+	v := sitemapTestVideo(id)
+	v.Description = "desc & more"
+	v.Categories = []*Category{{Name: "Animation"}}
+	return v
+}
+
+func TestVideoFeed_WriteRSS(t *testing.T) {
+	f := &VideoFeed{
+		Title:   "Showcase",
+		Link:    "https://vimeo.com/showcase/1",
+		Videos:  []*Video{feedTestVideo(1)},
+		Credits: map[string][]*Credit{"/videos/1": {{Name: "Jane", Role: "Director"}}},
+	}
+
+	var buf bytes.Buffer
+	if err := f.WriteRSS(&buf); err != nil {
+		t.Fatalf("VideoFeed.WriteRSS returned unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		`<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/">`,
+		`<lastBuildDate>Fri, 01 Jan 2021 00:00:01 +0000</lastBuildDate>`,
+		`<guid isPermaLink="true">https://vimeo.com/1</guid>`,
+		`<description>desc &amp; more</description>`,
+		`<pubDate>Thu, 02 Jan 2020 03:04:05 +0000</pubDate>`,
+		`<category>Animation</category>`,
+		`<media:player url="https://player.vimeo.com/video/1"></media:player>`,
+		`<media:thumbnail url="https://i.vimeocdn.com/large.jpg" width="1280"></media:thumbnail>`,
+		`<media:credit role="director" scheme="urn:ebu">Jane</media:credit>`,
+		`<media:keywords>go, vimeo</media:keywords>`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("VideoFeed.WriteRSS returned %v, want it to contain %v", buf.String(), want)
+		}
+	}
+
+	if strings.Contains(buf.String(), "<media:content") {
+		t.Errorf("VideoFeed.WriteRSS returned %v, want no media:content", buf.String())
+	}
+}
+
+func TestVideoFeed_WriteAtom(t *testing.T) {
+	f := &VideoFeed{Title: "Channel", Link: "https://vimeo.com/channels/staffpicks", Videos: []*Video{feedTestVideo(1)}}
+
+	var buf bytes.Buffer
+	if err := f.WriteAtom(&buf); err != nil {
+		t.Fatalf("VideoFeed.WriteAtom returned unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		`<feed xmlns="http://www.w3.org/2005/Atom" xmlns:media="http://search.yahoo.com/mrss/">`,
+		`<id>https://vimeo.com/channels/staffpicks</id>`,
+		`<updated>2021-01-01T00:00:01Z</updated>`,
+		`<published>2020-01-02T03:04:05Z</published>`,
+		`<link rel="alternate" href="https://vimeo.com/1"></link>`,
+		`<category term="Animation"></category>`,
+		`<name>Staff</name>`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("VideoFeed.WriteAtom returned %v, want it to contain %v", buf.String(), want)
+		}
+	}
+}
+
+func TestVideoFeed_WriteAtom_empty(t *testing.T) {
+	f := &VideoFeed{Title: "Channel", Link: "https://vimeo.com/channels/staffpicks"}
+
+	var buf bytes.Buffer
+	if err := f.WriteAtom(&buf); err != nil {
+		t.Fatalf("VideoFeed.WriteAtom returned unexpected error: %v", err)
+	}
+
+	if strings.Contains(buf.String(), "<updated>0001-01-01") {
+		t.Errorf("VideoFeed.WriteAtom returned %v, want the current time as updated", buf.String())
+	}
+}
+
+func TestVideosService_LoadCredits(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, id := range []int{1, 2} {
+		id := id
+		mux.HandleFunc(fmt.Sprintf("/videos/%d/credits", id), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			fmt.Fprintf(w, `{"data": [{"name": "Person %d"}]}`, id)
+		})
+	}
+
+	f := &VideoFeed{Videos: []*Video{{URI: "/videos/1"}, {URI: "/videos/2"}}}
+	if err := client.Videos.LoadCredits(f); err != nil {
+		t.Fatalf("Videos.LoadCredits returned unexpected error: %v", err)
+	}
+
+	if c := f.Credits["/videos/2"]; len(c) != 1 || c[0].Name != "Person 2" {
+		t.Errorf("Videos.LoadCredits credits of video 2 are %+v", c)
+	}
+}
+
+func TestFeedHandler(t *testing.T) {
+	var loads int
+	h := &FeedHandler{
+		Format: FeedAtom,
+		MaxAge: 5 * time.Minute,
+		Load: func(r *http.Request) (*VideoFeed, error) {
+			loads++
+			return &VideoFeed{Title: "Feed", Link: "https://example.com/", Videos: []*Video{feedTestVideo(1)}}, nil
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/feed", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("FeedHandler returned status %v, want %v", rec.Code, http.StatusOK)
+	}
+	if got := rec.Header().Get("Content-Type"); got != "application/atom+xml; charset=utf-8" {
+		t.Errorf("FeedHandler Content-Type is %v", got)
+	}
+	if got := rec.Header().Get("Cache-Control"); got != "public, max-age=300" {
+		t.Errorf("FeedHandler Cache-Control is %v", got)
+	}
+	if got := rec.Header().Get("Last-Modified"); got != "Fri, 01 Jan 2021 00:00:01 GMT" {
+		t.Errorf("FeedHandler Last-Modified is %v", got)
+	}
+
+	req := httptest.NewRequest("GET", "/feed", nil)
+	req.Header.Set("If-None-Match", rec.Header().Get("ETag"))
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("FeedHandler returned status %v, want %v", rec.Code, http.StatusNotModified)
+	}
+	if loads != 1 {
+		t.Errorf("FeedHandler loaded the feed %v times, want %v", loads, 1)
+	}
+}
+
+func TestFeedHandler_error(t *testing.T) {
+	h := &FeedHandler{Load: func(r *http.Request) (*VideoFeed, error) {
+		return nil, errors.New("boom")
+	}}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/feed", nil))
+
+	if rec.Code != http.StatusBadGateway {
+		t.Errorf("FeedHandler returned status %v, want %v", rec.Code, http.StatusBadGateway)
+	}
+}
+
+func TestFeedHandler_panic(t *testing.T) {
+	var loads int
+	h := &FeedHandler{Load: func(r *http.Request) (*VideoFeed, error) {
+		loads++
+		if loads == 1 {
+			panic("boom")
+		}
+		return &VideoFeed{Title: "Feed"}, nil
+	}}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("FeedHandler expected the Load panic")
+			}
+		}()
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/feed", nil))
+	}()
+
+	if len(h.loading) != 0 {
+		t.Errorf("FeedHandler kept %v loads after a panic, want none", len(h.loading))
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/feed", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("FeedHandler returned status %v, want %v", rec.Code, http.StatusOK)
+	}
+}
+
+func TestFeedHandler_key(t *testing.T) {
+	var loads int
+	h := &FeedHandler{
+		MaxAge: time.Minute,
+		Load: func(r *http.Request) (*VideoFeed, error) {
+			loads++
+			return &VideoFeed{Title: "Feed"}, nil
+		},
+	}
+
+	for _, u := range []string{"/feed", "/feed?x=1", "/feed?x=2"} {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", u, nil))
+	}
+	if loads != 1 {
+		t.Errorf("FeedHandler loaded the feed %v times, want %v", loads, 1)
+	}
+
+	h.Key = func(r *http.Request) string { return r.URL.Query().Get("lang") }
+	for _, u := range []string{"/feed?lang=en", "/feed?lang=en&x=1", "/feed?lang=fr"} {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", u, nil))
+	}
+	if loads != 3 {
+		t.Errorf("FeedHandler loaded the feed %v times, want %v", loads, 3)
+	}
+}
+
+func TestFeedHandler_maxEntries(t *testing.T) {
+	h := &FeedHandler{
+		MaxAge:     time.Minute,
+		MaxEntries: 2,
+		Load: func(r *http.Request) (*VideoFeed, error) {
+			return &VideoFeed{Title: "Feed"}, nil
+		},
+	}
+
+	for i := 0; i < 5; i++ {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", fmt.Sprintf("/feed/%d", i), nil))
+	}
+	if len(h.cache) != 2 {
+		t.Errorf("FeedHandler cached %v feeds, want %v", len(h.cache), 2)
+	}
+	if _, ok := h.cache["/feed/4"]; !ok {
+		t.Errorf("FeedHandler evicted the latest feed")
+	}
+}
+
+func TestFeedHandler_concurrent(t *testing.T) {
+	var loads int32
+	release := make(chan struct{})
+	h := &FeedHandler{
+		MaxAge: time.Minute,
+		Load: func(r *http.Request) (*VideoFeed, error) {
+			if r.URL.Path == "/feed" {
+				atomic.AddInt32(&loads, 1)
+				<-release
+			}
+			return &VideoFeed{Title: "Feed"}, nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest("GET", "/feed", nil))
+			if rec.Code != http.StatusOK {
+				t.Errorf("FeedHandler returned status %v, want %v", rec.Code, http.StatusOK)
+			}
+		}()
+	}
+
+	// A request for another key isn't blocked by the load in flight.
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/other", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("FeedHandler returned status %v, want %v", rec.Code, http.StatusOK)
+	}
+
+	close(release)
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&loads); n != 1 {
+		t.Errorf("FeedHandler loaded the feed %v times, want %v", n, 1)
+	}
+}
+
+func TestVideosService_LoadCredits_pages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/credits", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("page") == "2" {
+			fmt.Fprint(w, `{"data": [{"name": "Person 2"}], "paging": {"next": null}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": [{"name": "Person 1"}], "paging": {"next": "/videos/1/credits?page=2"}}`)
+	})
+
+	f := &VideoFeed{Videos: []*Video{{URI: "/videos/1"}}}
+	if err := client.Videos.LoadCredits(f); err != nil {
+		t.Fatalf("Videos.LoadCredits returned unexpected error: %v", err)
+	}
+	if c := f.Credits["/videos/1"]; len(c) != 2 {
+		t.Errorf("Videos.LoadCredits credits of video 1 are %+v, want 2", c)
+	}
+}
@@ -0,0 +1,58 @@
+//go:build ignore
+
+// Gen_reference loads the languages, content ratings and Creative Commons licenses
+// from the API and writes them to reference.json, the snapshot embedded in the package.
+//
+//	VIMEO_TOKEN=... go generate
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/silentsokolov/go-vimeo/v2/vimeo"
+)
+
+type tokenTransport struct {
+	token string
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func main() {
+	token := os.Getenv("VIMEO_TOKEN")
+	if token == "" {
+		log.Fatal("VIMEO_TOKEN is not set")
+	}
+
+	client := vimeo.NewClient(&http.Client{Transport: &tokenTransport{token}}, nil)
+
+	// Refresh loads the lists from the API, it fails rather than falling back to the snapshot.
+	r := vimeo.NewReferenceRegistry(client, 0)
+	if err := r.Refresh(); err != nil {
+		log.Fatal(err)
+	}
+	d, err := r.Data()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(d); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("reference.json", buf.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -19,23 +19,25 @@ type dataListGroup struct {
 
 // Group represents a group.
 type Group struct {
-	URI          string    `json:"uri,omitempty"`
-	Name         string    `json:"name,omitempty"`
-	Description  string    `json:"description,omitempty"`
-	Link         string    `json:"link,omitempty"`
-	CreatedTime  time.Time `json:"created_time,omitempty"`
-	ModifiedTime time.Time `json:"modified_time,omitempty"`
-	Privacy      *Privacy  `json:"privacy,omitempty"`
-	Pictures     *Pictures `json:"pictures,omitempty"`
-	Header       *Header   `json:"header,omitempty"`
-	User         *User     `json:"user,omitempty"`
-	ResourceKey  string    `json:"resource_key,omitempty"`
+	URI          string        `json:"uri,omitempty"`
+	Name         string        `json:"name,omitempty"`
+	Description  string        `json:"description,omitempty"`
+	Link         string        `json:"link,omitempty"`
+	CreatedTime  time.Time     `json:"created_time,omitempty"`
+	ModifiedTime time.Time     `json:"modified_time,omitempty"`
+	Privacy      *GroupPrivacy `json:"privacy,omitempty"`
+	Pictures     *Pictures     `json:"pictures,omitempty"`
+	Header       *Header       `json:"header,omitempty"`
+	User         *User         `json:"user,omitempty"`
+	Metadata     *Metadata     `json:"metadata,omitempty"`
+	ResourceKey  string        `json:"resource_key,omitempty"`
 }
 
 // GroupRequest represents a request to create/edit an group.
+// Nil fields are left out of the request.
 type GroupRequest struct {
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
 }
 
 // GetID returns the identifier (ID) of the group.
@@ -45,8 +45,8 @@ func TestGroupsService_Create(t *testing.T) {
 	defer teardown()
 
 	input := &GroupRequest{
-		Name:        "name",
-		Description: "desc",
+		Name:        String("name"),
+		Description: String("desc"),
 	}
 
 	mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
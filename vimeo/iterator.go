@@ -0,0 +1,87 @@
+package vimeo
+
+// ListFunc is a list method with its leading arguments bound, for example:
+//
+//	func(opt ...vimeo.CallOption) ([]*vimeo.Video, *vimeo.Response, error) {
+//		return client.Users.AlbumListVideo("", "123", opt...)
+//	}
+type ListFunc[T any] func(opt ...CallOption) ([]*T, *Response, error)
+
+// Iterator walks every item of a paginated list, requesting the pages one by one.
+//
+//	it := vimeo.NewIterator(client.Videos.MyList, vimeo.OptPerPage(100))
+//	for it.Next() {
+//		fmt.Println(it.Value().Name)
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	list  ListFunc[T]
+	opt   []CallOption
+	page  int
+	items []*T
+	cur   *T
+	done  bool
+	err   error
+	resp  *Response
+}
+
+// NewIterator returns an iterator over every page of list. The options are sent
+// with each request, OptPage is set by the iterator.
+func NewIterator[T any](list ListFunc[T], opt ...CallOption) *Iterator[T] {
+	return &Iterator[T]{list: list, opt: opt}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed.
+// It returns false at the end of the list or on error.
+func (it *Iterator[T]) Next() bool {
+	for len(it.items) == 0 {
+		if it.done || it.err != nil {
+			it.cur = nil
+			return false
+		}
+
+		it.page++
+		opt := append(append([]CallOption(nil), it.opt...), OptPage(it.page))
+
+		items, resp, err := it.list(opt...)
+		it.resp = resp
+		if err != nil {
+			it.err = err
+			continue
+		}
+
+		it.items = items
+		if len(items) == 0 || resp == nil || resp.NextPage == "" {
+			it.done = true
+		}
+	}
+
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Value returns the current item.
+func (it *Iterator[T]) Value() *T {
+	return it.cur
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Response returns the response of the last requested page.
+func (it *Iterator[T]) Response() *Response {
+	return it.resp
+}
+
+// All collects the remaining items of the iterator.
+func (it *Iterator[T]) All() ([]*T, error) {
+	var all []*T
+	for it.Next() {
+		all = append(all, it.Value())
+	}
+	return all, it.Err()
+}
@@ -0,0 +1,57 @@
+package vimeo
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIterator(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.URL.Query().Get("page"); page {
+		case "1":
+			fmt.Fprint(w, `{"data": [{"name": "a"}, {"name": "b"}], "paging": {"next": "/me/albums/1/videos?page=2"}}`)
+		case "2":
+			fmt.Fprint(w, `{"data": [{"name": "c"}], "paging": {"next": null}}`)
+		default:
+			t.Errorf("Iterator requested page %v", page)
+		}
+	})
+
+	it := NewIterator(func(opt ...CallOption) ([]*Video, *Response, error) {
+		return client.Users.AlbumListVideo("", "1", opt...)
+	}, OptPerPage(2))
+
+	videos, err := it.All()
+	if err != nil {
+		t.Fatalf("Iterator returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("Iterator returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestIterator_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error": "boom"}`, http.StatusInternalServerError)
+	})
+
+	it := NewIterator(client.Videos.List)
+	if it.Next() {
+		t.Error("Iterator.Next returned true, want false")
+	}
+
+	if it.Err() == nil {
+		t.Error("Iterator.Err expected error")
+	}
+}
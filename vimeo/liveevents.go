@@ -0,0 +1,289 @@
+package vimeo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LiveEventsService handles communication with the live events related
+// methods of the Vimeo API.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live
+type LiveEventsService service
+
+// LiveStatus is the status of a live stream.
+type LiveStatus string
+
+// A live stream goes from unavailable or pending to ready when the event is activated,
+// streaming (or streaming_preview) while the encoder sends video, then archiving and done
+// once the stream ends.
+const (
+	LiveStatusUnavailable      LiveStatus = "unavailable"
+	LiveStatusPending          LiveStatus = "pending"
+	LiveStatusReady            LiveStatus = "ready"
+	LiveStatusStreamingPreview LiveStatus = "streaming_preview"
+	LiveStatusStreaming        LiveStatus = "streaming"
+	LiveStatusStreamingError   LiveStatus = "streaming_error"
+	LiveStatusArchiving        LiveStatus = "archiving"
+	LiveStatusArchiveError     LiveStatus = "archive_error"
+	LiveStatusDone             LiveStatus = "done"
+)
+
+// IsStreaming reports whether the encoder is sending video.
+func (s LiveStatus) IsStreaming() bool {
+	return s == LiveStatusStreaming || s == LiveStatusStreamingPreview
+}
+
+// IsEnded reports whether the stream is over, successfully or not.
+func (s LiveStatus) IsEnded() bool {
+	switch s {
+	case LiveStatusArchiving, LiveStatusArchiveError, LiveStatusDone:
+		return true
+	}
+	return false
+}
+
+// VideoLive internal object provides access to the live status of a video.
+type VideoLive struct {
+	Status             LiveStatus `json:"status,omitempty"`
+	Link               string     `json:"link,omitempty"`
+	Key                string     `json:"key,omitempty"`
+	ScheduledStartTime time.Time  `json:"scheduled_start_time,omitempty"`
+	StreamingStartTime time.Time  `json:"streaming_start_time,omitempty"`
+	ActiveTime         time.Time  `json:"active_time,omitempty"`
+	EndedTime          time.Time  `json:"ended_time,omitempty"`
+	ArchivedTime       time.Time  `json:"archived_time,omitempty"`
+}
+
+// LiveEventPrivacy internal object provides access to the privacy of the live event stream.
+type LiveEventPrivacy struct {
+	View  ViewPrivacy  `json:"view,omitempty"`
+	Embed EmbedPrivacy `json:"embed,omitempty"`
+}
+
+// LiveEventSchedule internal object provides access to the schedule of a recurring live event.
+type LiveEventSchedule struct {
+	Type      string    `json:"type,omitempty"`
+	StartTime time.Time `json:"start_time,omitempty"`
+	DailyTime string    `json:"daily_time,omitempty"`
+	Weekdays  []string  `json:"weekdays,omitempty"`
+}
+
+// LiveEvent represents a live event.
+type LiveEvent struct {
+	URI                      string              `json:"uri,omitempty"`
+	Title                    string              `json:"title,omitempty"`
+	Link                     string              `json:"link,omitempty"`
+	CreatedTime              time.Time           `json:"created_time,omitempty"`
+	RTMPLink                 string              `json:"rtmp_link,omitempty"`
+	RTMPSLink                string              `json:"rtmps_link,omitempty"`
+	StreamKey                string              `json:"stream_key,omitempty"`
+	StreamTitle              string              `json:"stream_title,omitempty"`
+	StreamDescription        string              `json:"stream_description,omitempty"`
+	StreamPassword           string              `json:"stream_password,omitempty"`
+	StreamPrivacy            *LiveEventPrivacy   `json:"stream_privacy,omitempty"`
+	AutomaticallyTitleStream bool                `json:"automatically_title_stream"`
+	ContentRating            []ContentRatingCode `json:"content_rating,omitempty"`
+	Embed                    *EmbedSettings      `json:"embed,omitempty"`
+	LowLatency               bool                `json:"low_latency"`
+	PlaylistSort             string              `json:"playlist_sort,omitempty"`
+	Schedule                 *LiveEventSchedule  `json:"schedule,omitempty"`
+	NextOccurrenceTime       time.Time           `json:"next_occurrence_time,omitempty"`
+	TimeZone                 string              `json:"time_zone,omitempty"`
+	StreamableVideo          *Video              `json:"streamable_video,omitempty"`
+	Pictures                 *Pictures           `json:"pictures,omitempty"`
+	User                     *User               `json:"user,omitempty"`
+	Metadata                 *Metadata           `json:"metadata,omitempty"`
+}
+
+// LiveEventRequest represents a request to create/edit a live event.
+// Nil fields are left out of the request.
+type LiveEventRequest struct {
+	Title                    *string                `json:"title,omitempty"`
+	StreamTitle              *string                `json:"stream_title,omitempty"`
+	StreamDescription        *string                `json:"stream_description,omitempty"`
+	StreamPassword           *string                `json:"stream_password,omitempty"`
+	StreamPrivacy            *LiveEventPrivacy      `json:"stream_privacy,omitempty"`
+	AutomaticallyTitleStream *bool                  `json:"automatically_title_stream,omitempty"`
+	ContentRating            []ContentRatingCode    `json:"content_rating,omitempty"`
+	Embed                    *LiveEventEmbedRequest `json:"embed,omitempty"`
+	LowLatency               *bool                  `json:"low_latency,omitempty"`
+	PlaylistSort             *string                `json:"playlist_sort,omitempty"`
+	Schedule                 *LiveEventSchedule     `json:"schedule,omitempty"`
+	TimeZone                 *string                `json:"time_zone,omitempty"`
+	FolderURI                *string                `json:"folder_uri,omitempty"`
+}
+
+// LiveEventEmbedRequest a request to edit the embed settings of a live event.
+// Nil fields are left out of the request.
+type LiveEventEmbedRequest struct {
+	Color                  *string `json:"color,omitempty"`
+	Autoplay               *bool   `json:"autoplay,omitempty"`
+	Loop                   *bool   `json:"loop,omitempty"`
+	Playlist               *bool   `json:"playlist,omitempty"`
+	Schedule               *bool   `json:"schedule,omitempty"`
+	ShowLatestArchivedClip *bool   `json:"show_latest_archived_clip,omitempty"`
+	UseColor               *bool   `json:"use_color,omitempty"`
+	ChatEnabled            *bool   `json:"chat_enabled,omitempty"`
+	Responsive             *bool   `json:"responsive,omitempty"`
+	Logos                  *Logos  `json:"logos,omitempty"`
+}
+
+// LiveEventActivation represents the ingest information returned when a live event is activated.
+type LiveEventActivation struct {
+	URI             string `json:"uri,omitempty"`
+	Link            string `json:"link,omitempty"`
+	RTMPLink        string `json:"rtmp_link,omitempty"`
+	RTMPSLink       string `json:"rtmps_link,omitempty"`
+	StreamKey       string `json:"stream_key,omitempty"`
+	StreamableVideo *Video `json:"streamable_video,omitempty"`
+}
+
+// GetID returns the numeric identifier (ID) of the live event.
+func (e LiveEvent) GetID() int {
+	if ref, err := ParseRef(e.URI); err == nil && ref.Kind == RefLiveEvent {
+		ID, _ := strconv.Atoi(ref.ID)
+		return ID
+	}
+
+	l := strings.SplitN(e.URI, "/", -1)
+	ID, _ := strconv.Atoi(l[len(l)-1])
+	return ID
+}
+
+// Status returns the status of the current stream of the live event.
+func (e LiveEvent) Status() LiveStatus {
+	if e.StreamableVideo == nil || e.StreamableVideo.Live == nil || e.StreamableVideo.Live.Status == "" {
+		return LiveStatusUnavailable
+	}
+	return e.StreamableVideo.Live.Status
+}
+
+// IngestURL returns the address an encoder streams to, the RTMPS link when the event has one.
+// The stream key is sent separately by most encoders.
+func (e LiveEvent) IngestURL() string {
+	return ingestURL(e.RTMPSLink, e.RTMPLink)
+}
+
+// IngestURL returns the address an encoder streams to, the RTMPS link when the event has one.
+func (a LiveEventActivation) IngestURL() string {
+	return ingestURL(a.RTMPSLink, a.RTMPLink)
+}
+
+func ingestURL(rtmps, rtmp string) string {
+	if rtmps != "" {
+		return rtmps
+	}
+	return rtmp
+}
+
+func liveEventsPath(uid string) string {
+	if uid == "" {
+		return "me/live_events"
+	}
+	return fmt.Sprintf("users/%s/live_events", uid)
+}
+
+func liveEventPath(uid string, id int) string {
+	return fmt.Sprintf("%s/%d", liveEventsPath(uid), id)
+}
+
+// List method returns all the live events that belong to the user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#get_live_events
+func (s *LiveEventsService) List(uid string, opt ...CallOption) ([]*LiveEvent, *Response, error) {
+	return List[LiveEvent](s.client, liveEventsPath(uid), opt...)
+}
+
+// Create method creates a new live event.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#create_live_event
+func (s *LiveEventsService) Create(uid string, r *LiveEventRequest) (*LiveEvent, *Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	return Post[LiveEvent](s.client, liveEventsPath(uid), r)
+}
+
+// Get method returns a single live event.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#get_live_event
+func (s *LiveEventsService) Get(uid string, id int, opt ...CallOption) (*LiveEvent, *Response, error) {
+	return Get[LiveEvent](s.client, liveEventPath(uid, id), opt...)
+}
+
+// Edit method edits the specified live event, including its privacy and embed settings.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#edit_live_event
+func (s *LiveEventsService) Edit(uid string, id int, r *LiveEventRequest) (*LiveEvent, *Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	return Patch[LiveEvent](s.client, liveEventPath(uid, id), r)
+}
+
+// Delete method deletes the specified live event.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#delete_live_event
+func (s *LiveEventsService) Delete(uid string, id int) (*Response, error) {
+	return Delete(s.client, liveEventPath(uid, id))
+}
+
+// Activate method creates the stream of the live event and returns its RTMP/RTMPS ingest information.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#activate_live_event
+func (s *LiveEventsService) Activate(uid string, id int) (*LiveEventActivation, *Response, error) {
+	return Post[LiveEventActivation](s.client, liveEventPath(uid, id)+"/activate", nil)
+}
+
+// End method ends the current stream of the live event and returns the archived video.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#end_live_event
+func (s *LiveEventsService) End(uid string, id int) (*Video, *Response, error) {
+	return Post[Video](s.client, liveEventPath(uid, id)+"/end", nil)
+}
+
+// ListVideo method returns all the videos of the live event.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#get_live_event_videos
+func (s *LiveEventsService) ListVideo(uid string, id int, opt ...CallOption) ([]*Video, *Response, error) {
+	videos, resp, err := listVideo(s.client, liveEventPath(uid, id)+"/videos", opt...)
+
+	return videos, resp, err
+}
+
+// ListDomain method returns all the domains on which the live event can be embedded.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#get_live_event_privacy_domains
+func (s *LiveEventsService) ListDomain(uid string, id int, opt ...CallOption) ([]*Domain, *Response, error) {
+	return List[Domain](s.client, liveEventPath(uid, id)+"/privacy/domains", opt...)
+}
+
+// AllowDomain method adds the specified domain to the live event's embed allowlist.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#add_live_event_privacy_domain
+func (s *LiveEventsService) AllowDomain(uid string, id int, d string) (*Response, error) {
+	return Put(s.client, fmt.Sprintf("%s/privacy/domains/%s", liveEventPath(uid, id), d), nil)
+}
+
+// DisallowDomain method removes the specified domain from the live event's embed allowlist.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live#delete_live_event_privacy_domain
+func (s *LiveEventsService) DisallowDomain(uid string, id int, d string) (*Response, error) {
+	return Delete(s.client, fmt.Sprintf("%s/privacy/domains/%s", liveEventPath(uid, id), d))
+}
@@ -0,0 +1,249 @@
+package vimeo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestLiveEvent_GetID(t *testing.T) {
+	e := &LiveEvent{URI: "/users/1/live_events/2"}
+
+	if id := e.GetID(); id != 2 {
+		t.Errorf("LiveEvent.GetID returned %+v, want %+v", id, 2)
+	}
+}
+
+func TestLiveEvent_Status(t *testing.T) {
+	e := &LiveEvent{}
+	if s := e.Status(); s != LiveStatusUnavailable {
+		t.Errorf("LiveEvent.Status returned %v, want %v", s, LiveStatusUnavailable)
+	}
+
+	e.StreamableVideo = &Video{Live: &VideoLive{Status: LiveStatusStreaming}}
+	if s := e.Status(); s != LiveStatusStreaming || !s.IsStreaming() || s.IsEnded() {
+		t.Errorf("LiveEvent.Status returned %v, want %v", s, LiveStatusStreaming)
+	}
+
+	if !LiveStatusArchiving.IsEnded() || LiveStatusReady.IsEnded() {
+		t.Errorf("LiveStatus.IsEnded returned unexpected result")
+	}
+}
+
+func TestLiveEvent_IngestURL(t *testing.T) {
+	e := &LiveEvent{RTMPLink: "rtmp://rtmp.cloud.vimeo.com/live", RTMPSLink: "rtmps://rtmp-global.cloud.vimeo.com:443/live"}
+	if u := e.IngestURL(); u != e.RTMPSLink {
+		t.Errorf("LiveEvent.IngestURL returned %v, want %v", u, e.RTMPSLink)
+	}
+
+	e.RTMPSLink = ""
+	if u := e.IngestURL(); u != e.RTMPLink {
+		t.Errorf("LiveEvent.IngestURL returned %v, want %v", u, e.RTMPLink)
+	}
+}
+
+func TestLiveEventsService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"title": "Test"}]}`)
+	})
+
+	events, _, err := client.LiveEvents.List("", OptPage(1), OptPerPage(2))
+	if err != nil {
+		t.Errorf("LiveEvents.List returned unexpected error: %v", err)
+	}
+
+	want := []*LiveEvent{{Title: "Test"}}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("LiveEvents.List returned %+v, want %+v", events, want)
+	}
+}
+
+func TestLiveEventsService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &LiveEventRequest{
+		Title:         String("Weekly"),
+		StreamPrivacy: &LiveEventPrivacy{View: "unlisted", Embed: "whitelist"},
+		Embed:         &LiveEventEmbedRequest{Color: String("ff0000"), ChatEnabled: Bool(true)},
+	}
+
+	mux.HandleFunc("/users/1/live_events", func(w http.ResponseWriter, r *http.Request) {
+		v := &LiveEventRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("LiveEvents.Create returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("LiveEvents.Create body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"title": "Weekly", "stream_key": "key"}`)
+	})
+
+	event, _, err := client.LiveEvents.Create("1", input)
+	if err != nil {
+		t.Errorf("LiveEvents.Create returned unexpected error: %v", err)
+	}
+
+	want := &LiveEvent{Title: "Weekly", StreamKey: "key"}
+	if !reflect.DeepEqual(event, want) {
+		t.Errorf("LiveEvents.Create returned %+v, want %+v", event, want)
+	}
+}
+
+func TestLiveEventsService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"title": "Test", "streamable_video": {"live": {"status": "ready"}}}`)
+	})
+
+	event, _, err := client.LiveEvents.Get("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.Get returned unexpected error: %v", err)
+	}
+
+	if event.Title != "Test" || event.Status() != LiveStatusReady {
+		t.Errorf("LiveEvents.Get returned %+v", event)
+	}
+}
+
+func TestLiveEventsService_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"stream_title":"Episode 2"}`+"\n")
+		fmt.Fprint(w, `{"stream_title": "Episode 2"}`)
+	})
+
+	event, _, err := client.LiveEvents.Edit("", 2, &LiveEventRequest{StreamTitle: String("Episode 2")})
+	if err != nil {
+		t.Errorf("LiveEvents.Edit returned unexpected error: %v", err)
+	}
+
+	want := &LiveEvent{StreamTitle: "Episode 2"}
+	if !reflect.DeepEqual(event, want) {
+		t.Errorf("LiveEvents.Edit returned %+v, want %+v", event, want)
+	}
+}
+
+func TestLiveEventsService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.LiveEvents.Delete("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.Delete returned unexpected error: %v", err)
+	}
+}
+
+func TestLiveEventsService_Activate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2/activate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"rtmp_link": "rtmp://rtmp.cloud.vimeo.com/live", "stream_key": "key"}`)
+	})
+
+	a, _, err := client.LiveEvents.Activate("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.Activate returned unexpected error: %v", err)
+	}
+
+	want := &LiveEventActivation{RTMPLink: "rtmp://rtmp.cloud.vimeo.com/live", StreamKey: "key"}
+	if !reflect.DeepEqual(a, want) {
+		t.Errorf("LiveEvents.Activate returned %+v, want %+v", a, want)
+	}
+}
+
+func TestLiveEventsService_End(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2/end", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"name": "Test", "live": {"status": "archiving"}}`)
+	})
+
+	video, _, err := client.LiveEvents.End("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.End returned unexpected error: %v", err)
+	}
+
+	want := &Video{Name: "Test", Live: &VideoLive{Status: LiveStatusArchiving}}
+	if !reflect.DeepEqual(video, want) {
+		t.Errorf("LiveEvents.End returned %+v, want %+v", video, want)
+	}
+}
+
+func TestLiveEventsService_ListVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	videos, _, err := client.LiveEvents.ListVideo("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.ListVideo returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("LiveEvents.ListVideo returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestLiveEventsService_Domains(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events/2/privacy/domains", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "example.com"}]}`)
+	})
+	mux.HandleFunc("/me/live_events/2/privacy/domains/example.org", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" && r.Method != "DELETE" {
+			t.Errorf("Request method: %v, want PUT or DELETE", r.Method)
+		}
+	})
+
+	domains, _, err := client.LiveEvents.ListDomain("", 2)
+	if err != nil {
+		t.Errorf("LiveEvents.ListDomain returned unexpected error: %v", err)
+	}
+	if want := []*Domain{{Name: "example.com"}}; !reflect.DeepEqual(domains, want) {
+		t.Errorf("LiveEvents.ListDomain returned %+v, want %+v", domains, want)
+	}
+
+	if _, err := client.LiveEvents.AllowDomain("", 2, "example.org"); err != nil {
+		t.Errorf("LiveEvents.AllowDomain returned unexpected error: %v", err)
+	}
+	if _, err := client.LiveEvents.DisallowDomain("", 2, "example.org"); err != nil {
+		t.Errorf("LiveEvents.DisallowDomain returned unexpected error: %v", err)
+	}
+}
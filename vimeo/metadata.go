@@ -0,0 +1,97 @@
+package vimeo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Metadata internal object provides access to the connections and interactions of a resource.
+type Metadata struct {
+	Connections  map[string]*Connection  `json:"connections,omitempty"`
+	Interactions map[string]*Interaction `json:"interactions,omitempty"`
+}
+
+// Connection internal object describes a resource related to the current one.
+type Connection struct {
+	URI         string   `json:"uri,omitempty"`
+	Options     []string `json:"options,omitempty"`
+	Total       int      `json:"total,omitempty"`
+	CurrentURI  string   `json:"current_uri,omitempty"`
+	ResourceKey string   `json:"resource_key,omitempty"`
+}
+
+// Interaction internal object describes an action the authenticated user can take on a resource.
+type Interaction struct {
+	URI       string    `json:"uri,omitempty"`
+	Options   []string  `json:"options,omitempty"`
+	Added     bool      `json:"added"`
+	AddedTime time.Time `json:"added_time,omitempty"`
+	Reason    []string  `json:"reason,omitempty"`
+}
+
+// Connection returns the named connection, or nil if the resource doesn't expose it.
+func (m *Metadata) Connection(name string) *Connection {
+	if m == nil {
+		return nil
+	}
+	return m.Connections[name]
+}
+
+// Interaction returns the named interaction, or nil if the resource doesn't expose it.
+func (m *Metadata) Interaction(name string) *Interaction {
+	if m == nil {
+		return nil
+	}
+	return m.Interactions[name]
+}
+
+// Allows reports whether the connection accepts the given HTTP method.
+func (c *Connection) Allows(method string) bool {
+	for _, o := range c.Options {
+		if strings.EqualFold(o, method) {
+			return true
+		}
+	}
+	return false
+}
+
+type dataListConnection struct {
+	Data interface{} `json:"data"`
+	pagination
+}
+
+// FollowConnection method lists the resources behind a connection taken from a resource metadata.
+// The v argument must be a pointer to a slice of the resource type, for example *[]*Video.
+// The response carries pagination, so the next page can be requested with OptPage.
+func (c *Client) FollowConnection(conn *Connection, v interface{}, opt ...CallOption) (*Response, error) {
+	if conn == nil || conn.URI == "" {
+		return nil, errors.New("connection must have an URI")
+	}
+
+	if len(conn.Options) > 0 && !conn.Allows("GET") {
+		return nil, fmt.Errorf("connection %s can't be listed", conn.URI)
+	}
+
+	u, err := addOptions(conn.URI, opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &dataListConnection{Data: v}
+
+	resp, err := c.Do(req, list)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.setPaging(list)
+
+	return resp, err
+}
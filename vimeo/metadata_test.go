@@ -0,0 +1,76 @@
+package vimeo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestMetadata_Connection(t *testing.T) {
+	user := &User{}
+	err := json.Unmarshal([]byte(`{"metadata": {"connections": {"videos": {"uri": "/users/1/videos", "options": ["GET"], "total": 5}}}}`), user)
+	if err != nil {
+		t.Fatalf("json.Unmarshal returned unexpected error: %v", err)
+	}
+
+	want := &Connection{URI: "/users/1/videos", Options: []string{"GET"}, Total: 5}
+	if got := user.Metadata.Connection("videos"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Metadata.Connection returned %+v, want %+v", got, want)
+	}
+
+	if got := user.Metadata.Connection("albums"); got != nil {
+		t.Errorf("Metadata.Connection returned %+v, want nil", got)
+	}
+
+	var empty *Metadata
+	if got := empty.Connection("videos"); got != nil {
+		t.Errorf("Metadata.Connection on nil metadata returned %+v, want nil", got)
+	}
+}
+
+func TestClient_FollowConnection(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"page":     "2",
+			"per_page": "1",
+		})
+		fmt.Fprint(w, `{"total": 2, "page": 2, "paging": {"previous": "/users/1/videos?page=1"}, "data": [{"name": "Test"}]}`)
+	})
+
+	conn := &Connection{URI: "/users/1/videos", Options: []string{"GET"}, Total: 2}
+
+	var videos []*Video
+	resp, err := client.FollowConnection(conn, &videos, OptPage(2), OptPerPage(1))
+	if err != nil {
+		t.Fatalf("Client.FollowConnection returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("Client.FollowConnection returned %+v, want %+v", videos, want)
+	}
+
+	if resp.Page != 2 || resp.PrevPage != "/users/1/videos?page=1" {
+		t.Errorf("Client.FollowConnection response paging is %+v", resp)
+	}
+}
+
+func TestClient_FollowConnection_notListable(t *testing.T) {
+	c := NewClient(nil, nil)
+
+	var videos []*Video
+	if _, err := c.FollowConnection(nil, &videos); err == nil {
+		t.Errorf("Client.FollowConnection expected error for nil connection")
+	}
+
+	conn := &Connection{URI: "/videos/1/report", Options: []string{"POST"}}
+	if _, err := c.FollowConnection(conn, &videos); err == nil {
+		t.Errorf("Client.FollowConnection expected error for connection without GET")
+	}
+}
@@ -0,0 +1,272 @@
+package vimeo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const defaultOEmbedURL = "https://vimeo.com/api/oembed.json"
+
+// OEmbedClient manages communication with the Vimeo oEmbed endpoint.
+// The endpoint returns the public metadata and the embed code of a video
+// without an access token and doesn't count against the API rate limit.
+//
+// Vimeo oEmbed docs: https://developer.vimeo.com/api/oembed/videos
+type OEmbedClient struct {
+	client *http.Client
+
+	// BaseURL is the URL of the oEmbed endpoint.
+	BaseURL *url.URL
+
+	UserAgent string
+
+	// Config
+	Config *Config
+}
+
+// NewOEmbedClient returns a new Vimeo oEmbed client. If a nil httpClient is
+// provided, http.DefaultClient will be used. If a nil config is provided,
+// DefaultConfig will be used.
+func NewOEmbedClient(httpClient *http.Client, config *Config) *OEmbedClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	if config == nil {
+		config = DefaultConfig()
+	}
+	baseURL, _ := url.Parse(defaultOEmbedURL)
+
+	return &OEmbedClient{client: httpClient, BaseURL: baseURL, UserAgent: defaultUserAgent, Config: config}
+}
+
+// Client returns the HTTP client configured for this client.
+func (c *OEmbedClient) Client() *http.Client {
+	return c.client
+}
+
+// OEmbed represents the oEmbed data of a video.
+type OEmbed struct {
+	Type                       string `json:"type,omitempty"`
+	Version                    string `json:"version,omitempty"`
+	ProviderName               string `json:"provider_name,omitempty"`
+	ProviderURL                string `json:"provider_url,omitempty"`
+	Title                      string `json:"title,omitempty"`
+	AuthorName                 string `json:"author_name,omitempty"`
+	AuthorURL                  string `json:"author_url,omitempty"`
+	IsPlus                     string `json:"is_plus,omitempty"`
+	AccountType                string `json:"account_type,omitempty"`
+	HTML                       string `json:"html,omitempty"`
+	Width                      int    `json:"width,omitempty"`
+	Height                     int    `json:"height,omitempty"`
+	Duration                   int    `json:"duration,omitempty"`
+	Description                string `json:"description,omitempty"`
+	ThumbnailURL               string `json:"thumbnail_url,omitempty"`
+	ThumbnailWidth             int    `json:"thumbnail_width,omitempty"`
+	ThumbnailHeight            int    `json:"thumbnail_height,omitempty"`
+	ThumbnailURLWithPlayButton string `json:"thumbnail_url_with_play_button,omitempty"`
+	UploadDate                 string `json:"upload_date,omitempty"`
+	VideoID                    int    `json:"video_id,omitempty"`
+	URI                        string `json:"uri,omitempty"`
+}
+
+// Get method returns the oEmbed data of a video. The link can be anything ParseRef
+// understands, such as a vimeo.com or player.vimeo.com link, an API URI or a video ID;
+// it is sent to the endpoint as the canonical vimeo.com link.
+// The options configure the embedded player, for example OEmbedOptMaxWidth or OEmbedOptAutoplay.
+func (c *OEmbedClient) Get(link string, opt ...CallOption) (*OEmbed, *Response, error) {
+	ref, err := ParseRef(link)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := *c.BaseURL
+	qs := u.Query()
+	qs.Set("url", ref.WebURL())
+	for _, o := range opt {
+		qs.Set(o.Get())
+	}
+	u.RawQuery = qs.Encode()
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		io.CopyN(io.Discard, resp.Body, 512) // nolint: errcheck
+		resp.Body.Close()
+	}()
+
+	response := newResponse(resp)
+
+	// The endpoint answers errors with a plain text body.
+	if code := resp.StatusCode; code < 200 || code > 299 {
+		return nil, response, &ErrorResponse{Response: resp, Message: http.StatusText(code)}
+	}
+
+	oembed := &OEmbed{}
+	if err := json.NewDecoder(resp.Body).Decode(oembed); err != nil {
+		return nil, response, err
+	}
+
+	return oembed, response, nil
+}
+
+// OEmbedOptWidth is an optional argument to an oEmbed call. The exact width of the player.
+type OEmbedOptWidth int
+
+// Get key/value for make query
+func (o OEmbedOptWidth) Get() (string, string) {
+	return "width", fmt.Sprint(o)
+}
+
+// OEmbedOptMaxWidth is an optional argument to an oEmbed call. The maximum width of the player.
+type OEmbedOptMaxWidth int
+
+// Get key/value for make query
+func (o OEmbedOptMaxWidth) Get() (string, string) {
+	return "maxwidth", fmt.Sprint(o)
+}
+
+// OEmbedOptHeight is an optional argument to an oEmbed call. The exact height of the player.
+type OEmbedOptHeight int
+
+// Get key/value for make query
+func (o OEmbedOptHeight) Get() (string, string) {
+	return "height", fmt.Sprint(o)
+}
+
+// OEmbedOptMaxHeight is an optional argument to an oEmbed call. The maximum height of the player.
+type OEmbedOptMaxHeight int
+
+// Get key/value for make query
+func (o OEmbedOptMaxHeight) Get() (string, string) {
+	return "maxheight", fmt.Sprint(o)
+}
+
+// OEmbedOptAutoplay is an optional argument to an oEmbed call. Starts the video automatically.
+type OEmbedOptAutoplay bool
+
+// Get key/value for make query
+func (o OEmbedOptAutoplay) Get() (string, string) {
+	return "autoplay", fmt.Sprint(o)
+}
+
+// OEmbedOptAutopause is an optional argument to an oEmbed call. Pauses the video when another one starts.
+type OEmbedOptAutopause bool
+
+// Get key/value for make query
+func (o OEmbedOptAutopause) Get() (string, string) {
+	return "autopause", fmt.Sprint(o)
+}
+
+// OEmbedOptLoop is an optional argument to an oEmbed call. Plays the video again when it ends.
+type OEmbedOptLoop bool
+
+// Get key/value for make query
+func (o OEmbedOptLoop) Get() (string, string) {
+	return "loop", fmt.Sprint(o)
+}
+
+// OEmbedOptMuted is an optional argument to an oEmbed call. Starts the video without sound.
+type OEmbedOptMuted bool
+
+// Get key/value for make query
+func (o OEmbedOptMuted) Get() (string, string) {
+	return "muted", fmt.Sprint(o)
+}
+
+// OEmbedOptResponsive is an optional argument to an oEmbed call. Returns a player that scales with its container.
+type OEmbedOptResponsive bool
+
+// Get key/value for make query
+func (o OEmbedOptResponsive) Get() (string, string) {
+	return "responsive", fmt.Sprint(o)
+}
+
+// OEmbedOptByline is an optional argument to an oEmbed call. Shows the byline on the video.
+type OEmbedOptByline bool
+
+// Get key/value for make query
+func (o OEmbedOptByline) Get() (string, string) {
+	return "byline", fmt.Sprint(o)
+}
+
+// OEmbedOptPortrait is an optional argument to an oEmbed call. Shows the user's portrait on the video.
+type OEmbedOptPortrait bool
+
+// Get key/value for make query
+func (o OEmbedOptPortrait) Get() (string, string) {
+	return "portrait", fmt.Sprint(o)
+}
+
+// OEmbedOptTitle is an optional argument to an oEmbed call. Shows the title on the video.
+type OEmbedOptTitle bool
+
+// Get key/value for make query
+func (o OEmbedOptTitle) Get() (string, string) {
+	return "title", fmt.Sprint(o)
+}
+
+// OEmbedOptColor is an optional argument to an oEmbed call. The hexadecimal color of the player controls.
+type OEmbedOptColor string
+
+// Get key/value for make query
+func (o OEmbedOptColor) Get() (string, string) {
+	return "color", fmt.Sprint(o)
+}
+
+// OEmbedOptDNT is an optional argument to an oEmbed call. Prevents the player from tracking session data.
+type OEmbedOptDNT bool
+
+// Get key/value for make query
+func (o OEmbedOptDNT) Get() (string, string) {
+	return "dnt", fmt.Sprint(o)
+}
+
+// OEmbedOptPlaysInline is an optional argument to an oEmbed call. Plays the video inline on mobile devices.
+type OEmbedOptPlaysInline bool
+
+// Get key/value for make query
+func (o OEmbedOptPlaysInline) Get() (string, string) {
+	return "playsinline", fmt.Sprint(o)
+}
+
+// OEmbedOptBackground is an optional argument to an oEmbed call. Hides the controls, autoplays, loops and mutes the video.
+type OEmbedOptBackground bool
+
+// Get key/value for make query
+func (o OEmbedOptBackground) Get() (string, string) {
+	return "background", fmt.Sprint(o)
+}
+
+// OEmbedOptPlayerID is an optional argument to an oEmbed call. The ID of the player for the JavaScript API.
+type OEmbedOptPlayerID string
+
+// Get key/value for make query
+func (o OEmbedOptPlayerID) Get() (string, string) {
+	return "player_id", fmt.Sprint(o)
+}
+
+// OEmbedOptXHTML is an optional argument to an oEmbed call. Makes the embed code XHTML compliant.
+type OEmbedOptXHTML bool
+
+// Get key/value for make query
+func (o OEmbedOptXHTML) Get() (string, string) {
+	return "xhtml", fmt.Sprint(o)
+}
@@ -0,0 +1,110 @@
+package vimeo
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func setupOEmbed(t *testing.T, handler http.HandlerFunc) (*OEmbedClient, func()) {
+	server := httptest.NewServer(handler)
+
+	c := NewOEmbedClient(nil, nil)
+	u, err := url.Parse(server.URL + "/api/oembed.json")
+	if err != nil {
+		t.Fatalf("url.Parse returned unexpected error: %v", err)
+	}
+	c.BaseURL = u
+
+	return c, server.Close
+}
+
+func TestNewOEmbedClient(t *testing.T) {
+	c := NewOEmbedClient(nil, nil)
+	if baseURL := c.BaseURL.String(); baseURL != defaultOEmbedURL {
+		t.Errorf("NewOEmbedClient BaseURL is %v, want %v", baseURL, defaultOEmbedURL)
+	}
+
+	if client := c.Client(); client != http.DefaultClient {
+		t.Errorf("NewOEmbedClient Client is %+v, want %+v", client, http.DefaultClient)
+	}
+
+	if !reflect.DeepEqual(c.Config, DefaultConfig()) {
+		t.Errorf("NewOEmbedClient Config is %+v, want %+v", c.Config, DefaultConfig())
+	}
+
+	testClient := new(http.Client)
+	config := &Config{Concurrency: 1}
+	c = NewOEmbedClient(testClient, config)
+	if client := c.Client(); client != testClient {
+		t.Errorf("NewOEmbedClient Client is %+v, want %+v", client, testClient)
+	}
+	if c.Config != config {
+		t.Errorf("NewOEmbedClient Config is %+v, want %+v", c.Config, config)
+	}
+}
+
+func TestOEmbedClient_Get(t *testing.T) {
+	c, teardown := setupOEmbed(t, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Path != "/api/oembed.json" {
+			t.Errorf("OEmbed.Get path is %v, want %v", r.URL.Path, "/api/oembed.json")
+		}
+		testFormURLValues(t, r, values{
+			"url":        "https://vimeo.com/76979871/abcdef",
+			"maxwidth":   "640",
+			"autoplay":   "true",
+			"responsive": "true",
+		})
+		fmt.Fprint(w, `{"type": "video", "title": "The New Vimeo Player", "author_name": "Vimeo Staff", "video_id": 76979871, "width": 640, "html": "<iframe></iframe>"}`)
+	})
+	defer teardown()
+
+	oembed, _, err := c.Get("https://player.vimeo.com/video/76979871?h=abcdef", OEmbedOptMaxWidth(640), OEmbedOptAutoplay(true), OEmbedOptResponsive(true))
+	if err != nil {
+		t.Fatalf("OEmbed.Get returned unexpected error: %v", err)
+	}
+
+	want := &OEmbed{
+		Type:       "video",
+		Title:      "The New Vimeo Player",
+		AuthorName: "Vimeo Staff",
+		VideoID:    76979871,
+		Width:      640,
+		HTML:       "<iframe></iframe>",
+	}
+	if !reflect.DeepEqual(oembed, want) {
+		t.Errorf("OEmbed.Get returned %+v, want %+v", oembed, want)
+	}
+}
+
+func TestOEmbedClient_Get_notFound(t *testing.T) {
+	c, teardown := setupOEmbed(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "404 Not Found", http.StatusNotFound)
+	})
+	defer teardown()
+
+	_, resp, err := c.Get("1")
+	if err == nil {
+		t.Fatal("OEmbed.Get expected error")
+	}
+
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("OEmbed.Get returned %T, want *ErrorResponse", err)
+	}
+
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("OEmbed.Get response is %+v, want status %v", resp, http.StatusNotFound)
+	}
+}
+
+func TestOEmbedClient_Get_invalidLink(t *testing.T) {
+	c := NewOEmbedClient(nil, nil)
+
+	if _, _, err := c.Get("https://example.com/1"); err == nil {
+		t.Error("OEmbed.Get expected error for a foreign link")
+	}
+}
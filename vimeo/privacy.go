@@ -0,0 +1,381 @@
+package vimeo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ViewPrivacy is who can view a video.
+type ViewPrivacy string
+
+// The values of VideoPrivacy.View.
+const (
+	ViewAnybody  ViewPrivacy = "anybody"
+	ViewContacts ViewPrivacy = "contacts"
+	ViewDisable  ViewPrivacy = "disable"
+	ViewNobody   ViewPrivacy = "nobody"
+	ViewPassword ViewPrivacy = "password"
+	ViewUnlisted ViewPrivacy = "unlisted"
+	ViewUsers    ViewPrivacy = "users"
+)
+
+// EmbedPrivacy is where a video can be embedded.
+type EmbedPrivacy string
+
+// The values of VideoPrivacy.Embed.
+const (
+	EmbedPrivate   EmbedPrivacy = "private"
+	EmbedPublic    EmbedPrivacy = "public"
+	EmbedWhitelist EmbedPrivacy = "whitelist"
+)
+
+// CommentPrivacy is who can comment on a video.
+type CommentPrivacy string
+
+// The values of VideoPrivacy.Comment.
+const (
+	CommentAnybody  CommentPrivacy = "anybody"
+	CommentContacts CommentPrivacy = "contacts"
+	CommentNobody   CommentPrivacy = "nobody"
+)
+
+// License is the Creative Commons license of a video.
+type License string
+
+// The Creative Commons licenses.
+const (
+	LicenseBY     License = "by"
+	LicenseBYNC   License = "by-nc"
+	LicenseBYNCND License = "by-nc-nd"
+	LicenseBYNCSA License = "by-nc-sa"
+	LicenseBYND   License = "by-nd"
+	LicenseBYSA   License = "by-sa"
+	LicenseCC0    License = "cc0"
+)
+
+// ContentRatingCode is a content rating of a video.
+type ContentRatingCode string
+
+// The content ratings.
+const (
+	RatingSafe     ContentRatingCode = "safe"
+	RatingUnrated  ContentRatingCode = "unrated"
+	RatingDrugs    ContentRatingCode = "drugs"
+	RatingLanguage ContentRatingCode = "language"
+	RatingNudity   ContentRatingCode = "nudity"
+	RatingViolence ContentRatingCode = "violence"
+)
+
+// AlbumView is who can view an album.
+type AlbumView string
+
+// The values of AlbumPrivacy.View.
+const (
+	AlbumAnybody   AlbumView = "anybody"
+	AlbumEmbedOnly AlbumView = "embed_only"
+	AlbumNobody    AlbumView = "nobody"
+	AlbumPassword  AlbumView = "password"
+	AlbumTeam      AlbumView = "team"
+	AlbumUnlisted  AlbumView = "unlisted"
+)
+
+// AlbumSort is the default order of the videos of an album.
+type AlbumSort string
+
+// The values of Album.Sort.
+const (
+	AlbumSortAddedFirst   AlbumSort = "added_first"
+	AlbumSortAddedLast    AlbumSort = "added_last"
+	AlbumSortAlphabetical AlbumSort = "alphabetical"
+	AlbumSortArranged     AlbumSort = "arranged"
+	AlbumSortComments     AlbumSort = "comments"
+	AlbumSortLikes        AlbumSort = "likes"
+	AlbumSortNewest       AlbumSort = "newest"
+	AlbumSortOldest       AlbumSort = "oldest"
+	AlbumSortPlays        AlbumSort = "plays"
+)
+
+// ChannelView is who can view a channel.
+type ChannelView string
+
+// The values of ChannelPrivacy.View.
+const (
+	ChannelAnybody    ChannelView = "anybody"
+	ChannelModerators ChannelView = "moderators"
+	ChannelUsers      ChannelView = "users"
+)
+
+// GroupAccess is who can do something in a group.
+type GroupAccess string
+
+// The values of the GroupPrivacy fields.
+const (
+	GroupAnybody GroupAccess = "anybody"
+	GroupAll     GroupAccess = "all"
+	GroupMembers GroupAccess = "members"
+	GroupAdmins  GroupAccess = "admins"
+)
+
+// Valid reports whether v is a documented value.
+func (v ViewPrivacy) Valid() bool {
+	return containsString(v.values(), string(v))
+}
+
+func (ViewPrivacy) values() []string {
+	return enumStrings(ViewAnybody, ViewContacts, ViewDisable, ViewNobody, ViewPassword, ViewUnlisted, ViewUsers)
+}
+
+// Valid reports whether v is a documented value.
+func (v EmbedPrivacy) Valid() bool {
+	return containsString(v.values(), string(v))
+}
+
+func (EmbedPrivacy) values() []string {
+	return enumStrings(EmbedPrivate, EmbedPublic, EmbedWhitelist)
+}
+
+// Valid reports whether v is a documented value.
+func (v CommentPrivacy) Valid() bool {
+	return containsString(v.values(), string(v))
+}
+
+func (CommentPrivacy) values() []string {
+	return enumStrings(CommentAnybody, CommentContacts, CommentNobody)
+}
+
+// Valid reports whether v is a documented value.
+func (v License) Valid() bool {
+	return containsString(v.values(), string(v))
+}
+
+func (License) values() []string {
+	return enumStrings(
+		LicenseBY, LicenseBYNC, LicenseBYNCND, LicenseBYNCSA, LicenseBYND, LicenseBYSA, LicenseCC0,
+	)
+}
+
+// Valid reports whether v is a documented value.
+func (v ContentRatingCode) Valid() bool {
+	return containsString(v.values(), string(v))
+}
+
+func (ContentRatingCode) values() []string {
+	return enumStrings(RatingSafe, RatingUnrated, RatingDrugs, RatingLanguage, RatingNudity, RatingViolence)
+}
+
+// Valid reports whether v is a documented value.
+func (v AlbumView) Valid() bool {
+	return containsString(v.values(), string(v))
+}
+
+func (AlbumView) values() []string {
+	return enumStrings(AlbumAnybody, AlbumEmbedOnly, AlbumNobody, AlbumPassword, AlbumTeam, AlbumUnlisted)
+}
+
+// Valid reports whether v is a documented value.
+func (v AlbumSort) Valid() bool {
+	return containsString(v.values(), string(v))
+}
+
+func (AlbumSort) values() []string {
+	return enumStrings(
+		AlbumSortAddedFirst, AlbumSortAddedLast, AlbumSortAlphabetical, AlbumSortArranged,
+		AlbumSortComments, AlbumSortLikes, AlbumSortNewest, AlbumSortOldest, AlbumSortPlays,
+	)
+}
+
+// Valid reports whether v is a documented value.
+func (v ChannelView) Valid() bool {
+	return containsString(v.values(), string(v))
+}
+
+func (ChannelView) values() []string {
+	return enumStrings(ChannelAnybody, ChannelModerators, ChannelUsers)
+}
+
+// Valid reports whether v is a documented value.
+func (v GroupAccess) Valid() bool {
+	return containsString(v.values(), string(v))
+}
+
+func (GroupAccess) values() []string {
+	return enumStrings(GroupAnybody, GroupAll, GroupMembers, GroupAdmins)
+}
+
+// Privacy internal object provides access to privacy.
+//
+// Deprecated: Video, Album, Channel and Group use VideoPrivacy, AlbumPrivacy, ChannelPrivacy and GroupPrivacy.
+type Privacy struct {
+	View     string `json:"view,omitempty"`
+	Join     string `json:"join,omitempty"`
+	Videos   string `json:"videos,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+	Forums   string `json:"forums,omitempty"`
+	Invite   string `json:"invite,omitempty"`
+	Embed    string `json:"embed,omitempty"`
+	Download bool   `json:"download"`
+	Add      bool   `json:"add"`
+}
+
+// VideoPrivacy internal object provides access to the privacy of a video.
+type VideoPrivacy struct {
+	View     ViewPrivacy    `json:"view,omitempty"`
+	Embed    EmbedPrivacy   `json:"embed,omitempty"`
+	Comment  CommentPrivacy `json:"comment,omitempty"`
+	Download bool           `json:"download"`
+	Add      bool           `json:"add"`
+}
+
+// AlbumPrivacy internal object provides access to the privacy of an album.
+type AlbumPrivacy struct {
+	View     AlbumView `json:"view,omitempty"`
+	Password string    `json:"password,omitempty"`
+}
+
+// ChannelPrivacy internal object provides access to the privacy of a channel.
+type ChannelPrivacy struct {
+	View ChannelView `json:"view,omitempty"`
+}
+
+// GroupPrivacy internal object provides access to the privacy of a group.
+type GroupPrivacy struct {
+	View    GroupAccess `json:"view,omitempty"`
+	Join    GroupAccess `json:"join,omitempty"`
+	Videos  GroupAccess `json:"videos,omitempty"`
+	Comment GroupAccess `json:"comment,omitempty"`
+	Forums  GroupAccess `json:"forums,omitempty"`
+	Invite  GroupAccess `json:"invite,omitempty"`
+}
+
+// ValidationError is returned by the Validate methods of requests, and by the methods
+// that send them, when a field holds a value the API doesn't accept.
+type ValidationError struct {
+	Field string
+	Value string
+	// Suggestions are the accepted values closest to Value, if any.
+	Suggestions []string
+}
+
+func (e *ValidationError) Error() string {
+	msg := fmt.Sprintf("invalid %s %q", e.Field, e.Value)
+	if len(e.Suggestions) == 0 {
+		return msg
+	}
+
+	quoted := make([]string, len(e.Suggestions))
+	for i, s := range e.Suggestions {
+		quoted[i] = strconv.Quote(s)
+	}
+	alt := quoted[len(quoted)-1]
+	if len(quoted) > 1 {
+		alt = strings.Join(quoted[:len(quoted)-1], ", ") + " or " + alt
+	}
+
+	return msg + ", did you mean " + alt + "?"
+}
+
+// validator is implemented by the enums of the package.
+type validator interface {
+	~string
+	Valid() bool
+	values() []string
+}
+
+func enumStrings[T ~string](vs ...T) []string {
+	s := make([]string, len(vs))
+	for i, v := range vs {
+		s[i] = string(v)
+	}
+	return s
+}
+
+// validate reports an invalid v with the closest documented values as suggestions.
+func validate[T validator](field string, v *T) error {
+	if v == nil || (*v).Valid() {
+		return nil
+	}
+
+	values := (*v).values()
+	refs := make([]referenceEntry, len(values))
+	for i, code := range values {
+		refs[i] = referenceEntry{code: code}
+	}
+	return &ValidationError{Field: field, Value: string(*v), Suggestions: suggest(string(*v), refs)}
+}
+
+// Validate reports the first field that holds a value the API doesn't accept.
+// A nil request is valid.
+func (r *PrivacyRequest) Validate() error {
+	if r == nil {
+		return nil
+	}
+	if err := validate("privacy.view", r.View); err != nil {
+		return err
+	}
+	if err := validate("privacy.embed", r.Embed); err != nil {
+		return err
+	}
+	return validate("privacy.comment", r.Comment)
+}
+
+// Validate reports the first field that holds a value the API doesn't accept.
+func (r *VideoRequest) Validate() error {
+	if r == nil {
+		return nil
+	}
+	if err := validate("license", r.License); err != nil {
+		return err
+	}
+	for i := range r.ContentRating {
+		if err := validate("content_rating", &r.ContentRating[i]); err != nil {
+			return err
+		}
+	}
+	if r.Privacy != nil {
+		return r.Privacy.Validate()
+	}
+	return nil
+}
+
+// Validate reports the first field that holds a value the API doesn't accept.
+func (r *AlbumRequest) Validate() error {
+	if r == nil {
+		return nil
+	}
+	if err := validate("privacy", r.Privacy); err != nil {
+		return err
+	}
+	return validate("sort", r.Sort)
+}
+
+// Validate reports the first field that holds a value the API doesn't accept.
+func (r *ChannelRequest) Validate() error {
+	if r == nil {
+		return nil
+	}
+	return validate("privacy", r.Privacy)
+}
+
+// Validate reports the first field that holds a value the API doesn't accept.
+func (r *LiveEventRequest) Validate() error {
+	if r == nil {
+		return nil
+	}
+	for i := range r.ContentRating {
+		if err := validate("content_rating", &r.ContentRating[i]); err != nil {
+			return err
+		}
+	}
+	if p := r.StreamPrivacy; p != nil {
+		if p.View != "" {
+			if err := validate("stream_privacy.view", &p.View); err != nil {
+				return err
+			}
+		}
+		if p.Embed != "" {
+			return validate("stream_privacy.embed", &p.Embed)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package vimeo
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestVideoRequest_Validate(t *testing.T) {
+	tests := []struct {
+		r    *VideoRequest
+		want string
+	}{
+		{nil, ""},
+		{&VideoRequest{}, ""},
+		{NewVideoRequest().SetLicense(LicenseBYSA).SetContentRating(RatingSafe), ""},
+		{NewVideoRequest().SetLicense("by-xx"), `invalid license "by-xx", did you mean "by"?`},
+		{&VideoRequest{ContentRating: []ContentRatingCode{RatingSafe, "gore"}}, `invalid content_rating "gore"`},
+		{(&VideoRequest{}).SetPrivacy((&PrivacyRequest{}).SetView("friends")), `invalid privacy.view "friends"`},
+		{(&VideoRequest{}).SetPrivacy((&PrivacyRequest{}).SetEmbed("everywhere")), `invalid privacy.embed "everywhere"`},
+		{(&VideoRequest{}).SetPrivacy((&PrivacyRequest{}).SetView("Unlisted")), `invalid privacy.view "Unlisted", did you mean "unlisted"?`},
+	}
+
+	for _, tt := range tests {
+		err := tt.r.Validate()
+		if tt.want == "" {
+			if err != nil {
+				t.Errorf("VideoRequest.Validate returned unexpected error: %v", err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("VideoRequest.Validate returned %v, want %q", err, tt.want)
+		}
+	}
+}
+
+func TestAlbumRequest_Validate(t *testing.T) {
+	if err := (&AlbumRequest{}).SetPrivacy(AlbumEmbedOnly).SetSort(AlbumSortPlays).Validate(); err != nil {
+		t.Errorf("AlbumRequest.Validate returned unexpected error: %v", err)
+	}
+
+	err := (&AlbumRequest{}).SetSort("random").Validate()
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Field != "sort" || verr.Value != "random" {
+		t.Errorf("AlbumRequest.Validate returned %v, want invalid sort", err)
+	}
+}
+
+func TestGroupAccess_Valid(t *testing.T) {
+	if !GroupMembers.Valid() {
+		t.Errorf("GroupAccess %q is not valid", GroupMembers)
+	}
+	if GroupAccess("everyone").Valid() {
+		t.Errorf("GroupAccess %q is valid", "everyone")
+	}
+}
+
+func TestLiveEventRequest_Validate(t *testing.T) {
+	tests := []struct {
+		r    *LiveEventRequest
+		want string
+	}{
+		{nil, ""},
+		{&LiveEventRequest{ContentRating: []ContentRatingCode{RatingSafe}, StreamPrivacy: &LiveEventPrivacy{View: ViewUnlisted}}, ""},
+		{&LiveEventRequest{ContentRating: []ContentRatingCode{"violent"}}, `invalid content_rating "violent", did you mean "violence"?`},
+		{&LiveEventRequest{StreamPrivacy: &LiveEventPrivacy{View: "friends"}}, `invalid stream_privacy.view "friends"`},
+		{&LiveEventRequest{StreamPrivacy: &LiveEventPrivacy{Embed: "white-list"}}, `invalid stream_privacy.embed "white-list", did you mean "whitelist"?`},
+	}
+
+	for _, tt := range tests {
+		err := tt.r.Validate()
+		if tt.want == "" {
+			if err != nil {
+				t.Errorf("LiveEventRequest.Validate returned unexpected error: %v", err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("LiveEventRequest.Validate returned %v, want %q", err, tt.want)
+		}
+	}
+}
+
+func TestChannelRequest_Validate(t *testing.T) {
+	if err := (&ChannelRequest{}).SetPrivacy("nobody").Validate(); err == nil {
+		t.Errorf("ChannelRequest.Validate expected error")
+	}
+}
+
+func TestVideosService_Edit_invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Videos.Edit sent an invalid request")
+	})
+
+	_, _, err := client.Videos.Edit(VideoID{ID: 1}, NewVideoRequest().SetLicense("by-xx"))
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Errorf("Videos.Edit returned %v, want a ValidationError", err)
+	}
+}
+
+func TestUsersService_CreateAlbum_invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Users.CreateAlbum sent an invalid request")
+	})
+
+	if _, _, err := client.Users.CreateAlbum("", (&AlbumRequest{}).SetPrivacy("secret")); err == nil {
+		t.Errorf("Users.CreateAlbum expected error")
+	}
+}
+
+func TestLiveEventsService_Create_invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/live_events", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("LiveEvents.Create sent an invalid request")
+	})
+
+	_, _, err := client.LiveEvents.Create("", &LiveEventRequest{ContentRating: []ContentRatingCode{"gore"}})
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Errorf("LiveEvents.Create returned %v, want a ValidationError", err)
+	}
+}
@@ -0,0 +1,97 @@
+package vimeo
+
+// The functions below are an escape hatch for endpoints the library doesn't wrap yet.
+// Go doesn't allow type parameters on methods, so they take the Client as the first argument.
+
+type dataList[T any] struct {
+	Data []*T `json:"data"`
+	pagination
+}
+
+// Get sends a GET request to the API path and decodes the response into a new T.
+func Get[T any](c *Client, path string, opt ...CallOption) (*T, *Response, error) {
+	return send[T](c, "GET", path, nil, opt...)
+}
+
+// List sends a GET request to the API path and decodes the data of a paginated
+// response into a slice of T. The pagination is available in the Response.
+func List[T any](c *Client, path string, opt ...CallOption) ([]*T, *Response, error) {
+	u, err := addOptions(path, opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list := &dataList[T]{}
+
+	resp, err := c.Do(req, list)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(list)
+
+	return list.Data, resp, err
+}
+
+// Post sends a POST request with the JSON encoded body to the API path
+// and decodes the response into a new T.
+func Post[T any](c *Client, path string, body interface{}, opt ...CallOption) (*T, *Response, error) {
+	return send[T](c, "POST", path, body, opt...)
+}
+
+// Patch sends a PATCH request with the JSON encoded body to the API path
+// and decodes the response into a new T.
+func Patch[T any](c *Client, path string, body interface{}, opt ...CallOption) (*T, *Response, error) {
+	return send[T](c, "PATCH", path, body, opt...)
+}
+
+// Put sends a PUT request with the JSON encoded body to the API path.
+// Passing a nil body sends an empty request.
+func Put(c *Client, path string, body interface{}, opt ...CallOption) (*Response, error) {
+	return sendNoContent(c, "PUT", path, body, opt...)
+}
+
+// Delete sends a DELETE request to the API path.
+func Delete(c *Client, path string, opt ...CallOption) (*Response, error) {
+	return sendNoContent(c, "DELETE", path, nil, opt...)
+}
+
+func send[T any](c *Client, method, path string, body interface{}, opt ...CallOption) (*T, *Response, error) {
+	u, err := addOptions(path, opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest(method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(T)
+
+	resp, err := c.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, err
+}
+
+func sendNoContent(c *Client, method, path string, body interface{}, opt ...CallOption) (*Response, error) {
+	u, err := addOptions(path, opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(req, nil)
+}
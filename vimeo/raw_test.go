@@ -0,0 +1,176 @@
+package vimeo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+type rawTestItem struct {
+	Name string `json:"name,omitempty"`
+}
+
+func TestGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/endpoints/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"fields": "name",
+		})
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	item, _, err := Get[rawTestItem](client, "endpoints/1", OptFields([]string{"name"}))
+	if err != nil {
+		t.Errorf("Get returned unexpected error: %v", err)
+	}
+
+	want := &rawTestItem{Name: "Test"}
+	if !reflect.DeepEqual(item, want) {
+		t.Errorf("Get returned %+v, want %+v", item, want)
+	}
+}
+
+func TestList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/endpoints", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"total": 3, "page": 1, "paging": {"next": "/endpoints?page=2"}, "data": [{"name": "Test"}]}`)
+	})
+
+	items, resp, err := List[rawTestItem](client, "endpoints", OptPage(1), OptPerPage(2))
+	if err != nil {
+		t.Errorf("List returned unexpected error: %v", err)
+	}
+
+	want := []*rawTestItem{{Name: "Test"}}
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("List returned %+v, want %+v", items, want)
+	}
+
+	if resp.Total != 3 || resp.NextPage != "/endpoints?page=2" {
+		t.Errorf("List response paging is %+v", resp)
+	}
+}
+
+func TestList_httpError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/endpoints", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error": "Not found"}`, http.StatusNotFound)
+	})
+
+	items, resp, err := List[rawTestItem](client, "endpoints")
+	if err == nil {
+		t.Errorf("List expected error")
+	}
+
+	if items != nil {
+		t.Errorf("List returned %+v, want nil", items)
+	}
+
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("List returned response %+v, want status %v", resp, http.StatusNotFound)
+	}
+}
+
+func TestPost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &rawTestItem{Name: "name"}
+
+	mux.HandleFunc("/endpoints", func(w http.ResponseWriter, r *http.Request) {
+		v := &rawTestItem{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("Post returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Post body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"name": "name"}`)
+	})
+
+	item, _, err := Post[rawTestItem](client, "endpoints", input)
+	if err != nil {
+		t.Errorf("Post returned unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(item, input) {
+		t.Errorf("Post returned %+v, want %+v", item, input)
+	}
+}
+
+func TestPatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &rawTestItem{Name: "name"}
+
+	mux.HandleFunc("/endpoints/1", func(w http.ResponseWriter, r *http.Request) {
+		v := &rawTestItem{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("Patch returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Patch body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"name": "name"}`)
+	})
+
+	item, _, err := Patch[rawTestItem](client, "endpoints/1", input)
+	if err != nil {
+		t.Errorf("Patch returned unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(item, input) {
+		t.Errorf("Patch returned %+v, want %+v", item, input)
+	}
+}
+
+func TestPut(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/endpoints/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	_, err := Put(client, "endpoints/1", nil)
+	if err != nil {
+		t.Errorf("Put returned unexpected error: %v", err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/endpoints/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := Delete(client, "endpoints/1")
+	if err != nil {
+		t.Errorf("Delete returned unexpected error: %v", err)
+	}
+}
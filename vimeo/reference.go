@@ -0,0 +1,375 @@
+package vimeo
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultReferenceTTL is how long a ReferenceRegistry keeps the lists it loaded.
+const DefaultReferenceTTL = 24 * time.Hour
+
+// maxSuggestions limits the suggestions of a ValidationError.
+const maxSuggestions = 3
+
+// The snapshot is regenerated from the API with VIMEO_TOKEN set.
+//go:generate go run gen_reference.go
+
+//go:embed reference.json
+var referenceSnapshot []byte
+
+// ReferenceData holds the reference lists of the API: the video languages,
+// the content ratings and the Creative Commons licenses.
+type ReferenceData struct {
+	Languages       []*Language       `json:"languages"`
+	ContentRatings  []*ContentRating  `json:"content_ratings"`
+	CreativeCommons []*CreativeCommon `json:"creative_commons"`
+}
+
+// SnapshotReference returns the reference lists embedded in the package.
+// The snapshot works offline but may lag behind the API.
+func SnapshotReference() *ReferenceData {
+	d := &ReferenceData{}
+	if err := json.Unmarshal(referenceSnapshot, d); err != nil {
+		panic("vimeo: invalid reference snapshot: " + err.Error())
+	}
+	return d
+}
+
+// referenceRetryDelay is how long a ReferenceRegistry waits after a failed load before trying again.
+const referenceRetryDelay = time.Minute
+
+// ReferenceRegistry loads the reference lists of the API and keeps them for TTL,
+// and validates requests against them.
+//
+// A registry is seeded with SnapshotReference. The seed is used when the registry
+// has no client, and as a fallback when the lists can't be loaded.
+type ReferenceRegistry struct {
+	client *Client
+	ttl    time.Duration
+
+	mu      sync.Mutex
+	data    *ReferenceData
+	loaded  bool
+	expires time.Time
+	retry   time.Time
+	err     error
+	loading chan struct{}
+}
+
+// NewReferenceRegistry returns a registry that loads the lists with c and keeps them
+// for ttl, DefaultReferenceTTL when zero. A nil client uses the embedded snapshot only.
+func NewReferenceRegistry(c *Client, ttl time.Duration) *ReferenceRegistry {
+	if ttl <= 0 {
+		ttl = DefaultReferenceTTL
+	}
+	return &ReferenceRegistry{client: c, ttl: ttl, data: SnapshotReference()}
+}
+
+// Seed replaces the lists of the registry, for example with a snapshot saved by the caller.
+// Seeded lists are used until the next load.
+func (r *ReferenceRegistry) Seed(d *ReferenceData) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.data = d
+	r.loaded = false
+	r.expires = time.Time{}
+	r.retry = time.Time{}
+	r.err = nil
+}
+
+// Data returns the reference lists, loading them when they are older than TTL.
+// Only one load runs at a time, and the lists are loaded outside the lock: while a load
+// is in flight, the current lists are returned. When the lists can't be loaded, the seeded
+// or previously loaded lists are returned and the next load waits a minute; the error
+// is returned only when there are no lists.
+func (r *ReferenceRegistry) Data() (*ReferenceData, error) {
+	r.mu.Lock()
+
+	now := time.Now()
+	fresh := r.loaded && now.Before(r.expires)
+	if r.client == nil || fresh || now.Before(r.retry) {
+		defer r.mu.Unlock()
+		return r.result()
+	}
+
+	if done := r.loading; done != nil {
+		if r.data != nil {
+			defer r.mu.Unlock()
+			return r.result()
+		}
+		r.mu.Unlock()
+		<-done
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return r.result()
+	}
+
+	done := make(chan struct{})
+	r.loading = done
+	r.mu.Unlock()
+
+	r.run(done)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.result()
+}
+
+// Refresh loads the reference lists now, whatever their age.
+// When a load is already in flight, Refresh waits for it and returns its error.
+func (r *ReferenceRegistry) Refresh() error {
+	if r.client == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	if done := r.loading; done != nil {
+		r.mu.Unlock()
+		<-done
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return r.err
+	}
+
+	done := make(chan struct{})
+	r.loading = done
+	r.mu.Unlock()
+
+	return r.run(done)
+}
+
+// run loads the lists for the load in flight done, records the result
+// and releases the callers waiting for it.
+func (r *ReferenceRegistry) run(done chan struct{}) error {
+	d, err := r.load()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.set(d, err)
+	r.loading = nil
+	close(done)
+
+	return err
+}
+
+// set records the result of a load. It's called with r.mu held.
+func (r *ReferenceRegistry) set(d *ReferenceData, err error) {
+	if err != nil {
+		r.err = err
+		r.retry = time.Now().Add(referenceRetryDelay)
+		return
+	}
+
+	r.data = d
+	r.loaded = true
+	r.expires = time.Now().Add(r.ttl)
+	r.retry = time.Time{}
+	r.err = nil
+}
+
+// result returns the lists, or the error of the last load when there are none.
+// It's called with r.mu held.
+func (r *ReferenceRegistry) result() (*ReferenceData, error) {
+	if r.data != nil {
+		return r.data, nil
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return nil, errors.New("no reference data")
+}
+
+func (r *ReferenceRegistry) load() (*ReferenceData, error) {
+	languages, err := NewIterator(r.client.Languages.List, OptPerPage(maxBatchSize)).All()
+	if err != nil {
+		return nil, err
+	}
+	ratings, err := NewIterator(r.client.ContentRatings.List, OptPerPage(maxBatchSize)).All()
+	if err != nil {
+		return nil, err
+	}
+	commons, err := NewIterator(r.client.CreativeCommons.List, OptPerPage(maxBatchSize)).All()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReferenceData{Languages: languages, ContentRatings: ratings, CreativeCommons: commons}, nil
+}
+
+// ValidateVideo checks the locale, license and content ratings of v against the reference lists.
+// An unknown value is reported as a *ValidationError with the closest accepted values.
+func (r *ReferenceRegistry) ValidateVideo(v *VideoRequest) error {
+	if v == nil {
+		return nil
+	}
+
+	d, err := r.Data()
+	if err != nil {
+		return err
+	}
+
+	if v.Locale != nil {
+		if err := checkReference("locale", *v.Locale, languageRefs(d.Languages)); err != nil {
+			return err
+		}
+	}
+	if v.License != nil {
+		if err := checkReference("license", string(*v.License), commonRefs(d.CreativeCommons)); err != nil {
+			return err
+		}
+	}
+	ratings := ratingRefs(d.ContentRatings)
+	for _, c := range v.ContentRating {
+		if err := checkReference("content_rating", string(c), ratings); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateTextTrack checks the language of t against the reference lists.
+// An unknown value is reported as a *ValidationError with the closest accepted values.
+func (r *ReferenceRegistry) ValidateTextTrack(t *TextTrackRequest) error {
+	if t == nil || t.Language == nil {
+		return nil
+	}
+
+	d, err := r.Data()
+	if err != nil {
+		return err
+	}
+
+	return checkReference("language", *t.Language, languageRefs(d.Languages))
+}
+
+// referenceEntry is a code of a reference list and its display name.
+type referenceEntry struct {
+	code string
+	name string
+}
+
+func languageRefs(l []*Language) []referenceEntry {
+	refs := make([]referenceEntry, 0, len(l))
+	for _, v := range l {
+		refs = append(refs, referenceEntry{v.Code, v.Name})
+	}
+	return refs
+}
+
+func ratingRefs(l []*ContentRating) []referenceEntry {
+	refs := make([]referenceEntry, 0, len(l))
+	for _, v := range l {
+		refs = append(refs, referenceEntry{v.Code, v.Name})
+	}
+	return refs
+}
+
+func commonRefs(l []*CreativeCommon) []referenceEntry {
+	refs := make([]referenceEntry, 0, len(l))
+	for _, v := range l {
+		refs = append(refs, referenceEntry{v.Code, v.Name})
+	}
+	return refs
+}
+
+func checkReference(field, value string, refs []referenceEntry) error {
+	for _, e := range refs {
+		if e.code == value {
+			return nil
+		}
+	}
+	return &ValidationError{Field: field, Value: value, Suggestions: suggest(value, refs)}
+}
+
+// suggest returns the codes closest to value: a code that differs only in case,
+// whose name is value, that is the language of a regional value such as "fr-BE",
+// or that is a few edits away.
+func suggest(value string, refs []referenceEntry) []string {
+	type candidate struct {
+		code string
+		dist int
+	}
+
+	v := strings.ToLower(value)
+	var candidates []candidate
+	for _, e := range refs {
+		code := strings.ToLower(e.code)
+
+		var dist int
+		switch {
+		case code == v || strings.EqualFold(e.name, value):
+			dist = 0
+		case strings.HasPrefix(v, code+"-"):
+			dist = 2
+		default:
+			dist = editDistance(v, code)
+			limit := len(code) / 3
+			if limit < 1 {
+				limit = 1
+			}
+			if dist > limit {
+				continue
+			}
+		}
+		candidates = append(candidates, candidate{e.code, dist})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].dist != candidates[j].dist {
+			return candidates[i].dist < candidates[j].dist
+		}
+		return candidates[i].code < candidates[j].code
+	})
+	if len(candidates) > maxSuggestions {
+		candidates = candidates[:maxSuggestions]
+	}
+
+	codes := make([]string, len(candidates))
+	for i, c := range candidates {
+		codes[i] = c.code
+	}
+	return codes
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func minInt(v int, vs ...int) int {
+	for _, x := range vs {
+		if x < v {
+			v = x
+		}
+	}
+	return v
+}
@@ -0,0 +1,236 @@
+package vimeo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSnapshotReference(t *testing.T) {
+	d := SnapshotReference()
+	if len(d.Languages) == 0 || len(d.ContentRatings) == 0 || len(d.CreativeCommons) == 0 {
+		t.Fatalf("SnapshotReference returned empty lists: %+v", d)
+	}
+
+	for _, c := range d.CreativeCommons {
+		if !License(c.Code).Valid() {
+			t.Errorf("SnapshotReference license %q is not a License", c.Code)
+		}
+	}
+	for _, c := range d.ContentRatings {
+		if !ContentRatingCode(c.Code).Valid() {
+			t.Errorf("SnapshotReference content rating %q is not a ContentRatingCode", c.Code)
+		}
+	}
+}
+
+func TestReferenceRegistry_Data(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/languages", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"code": "en", "name": "English"}]}`)
+	})
+	mux.HandleFunc("/contentratings", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": [{"code": "safe", "name": "All Audiences"}]}`)
+	})
+	mux.HandleFunc("/creativecommons", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": [{"code": "by", "name": "Attribution"}]}`)
+	})
+
+	reg := NewReferenceRegistry(client, time.Hour)
+	for i := 0; i < 2; i++ {
+		d, err := reg.Data()
+		if err != nil {
+			t.Fatalf("ReferenceRegistry.Data returned unexpected error: %v", err)
+		}
+		want := &ReferenceData{
+			Languages:       []*Language{{Code: "en", Name: "English"}},
+			ContentRatings:  []*ContentRating{{Code: "safe", Name: "All Audiences"}},
+			CreativeCommons: []*CreativeCommon{{Code: "by", Name: "Attribution"}},
+		}
+		if !reflect.DeepEqual(d, want) {
+			t.Errorf("ReferenceRegistry.Data returned %+v, want %+v", d, want)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("ReferenceRegistry.Data loaded languages %d times, want 1", calls)
+	}
+
+	if err := reg.Refresh(); err != nil {
+		t.Fatalf("ReferenceRegistry.Refresh returned unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("ReferenceRegistry.Refresh loaded languages %d times, want 2", calls)
+	}
+}
+
+func TestReferenceRegistry_Data_fallback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/languages", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"error": "Server error"}`)
+	})
+
+	reg := NewReferenceRegistry(client, 0)
+	d, err := reg.Data()
+	if err != nil {
+		t.Fatalf("ReferenceRegistry.Data returned unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(d, SnapshotReference()) {
+		t.Errorf("ReferenceRegistry.Data returned %+v, want the snapshot", d)
+	}
+
+	reg.Seed(nil)
+	if _, err := reg.Data(); err == nil {
+		t.Errorf("ReferenceRegistry.Data expected error")
+	}
+}
+
+func TestReferenceRegistry_ValidateVideo(t *testing.T) {
+	reg := NewReferenceRegistry(nil, 0)
+
+	tests := []struct {
+		r    *VideoRequest
+		want *ValidationError
+	}{
+		{nil, nil},
+		{NewVideoRequest().SetLocale("pt-BR").SetLicense(LicenseBYNC), nil},
+		{NewVideoRequest().SetLocale("bn"), nil},
+		{NewVideoRequest().SetLocale("sw"), nil},
+		{NewVideoRequest().SetLocale("en-AU"), nil},
+		{NewVideoRequest().SetLocale("en-us"), &ValidationError{Field: "locale", Value: "en-us", Suggestions: []string{"en-US", "es-US", "en"}}},
+		{NewVideoRequest().SetLocale("German"), &ValidationError{Field: "locale", Value: "German", Suggestions: []string{"de"}}},
+		{NewVideoRequest().SetLocale("fr-LU"), &ValidationError{Field: "locale", Value: "fr-LU", Suggestions: []string{"fr"}}},
+		{NewVideoRequest().SetLicense("by-ns"), &ValidationError{Field: "license", Value: "by-ns", Suggestions: []string{"by-nc", "by-nd", "by"}}},
+		{&VideoRequest{ContentRating: []ContentRatingCode{RatingSafe, "violent"}}, &ValidationError{Field: "content_rating", Value: "violent", Suggestions: []string{"violence"}}},
+		{NewVideoRequest().SetLocale("xyz"), &ValidationError{Field: "locale", Value: "xyz", Suggestions: []string{}}},
+	}
+
+	for _, tt := range tests {
+		err := reg.ValidateVideo(tt.r)
+		if tt.want == nil {
+			if err != nil {
+				t.Errorf("ReferenceRegistry.ValidateVideo returned unexpected error: %v", err)
+			}
+			continue
+		}
+		var verr *ValidationError
+		if !errors.As(err, &verr) || !reflect.DeepEqual(verr, tt.want) {
+			t.Errorf("ReferenceRegistry.ValidateVideo returned %#v, want %#v", err, tt.want)
+		}
+	}
+}
+
+func TestReferenceRegistry_ValidateTextTrack(t *testing.T) {
+	reg := NewReferenceRegistry(nil, 0)
+
+	if err := reg.ValidateTextTrack(&TextTrackRequest{Language: String("ja")}); err != nil {
+		t.Errorf("ReferenceRegistry.ValidateTextTrack returned unexpected error: %v", err)
+	}
+
+	err := reg.ValidateTextTrack(&TextTrackRequest{Language: String("jp")})
+	want := `invalid language "jp", did you mean "ja" or "jv"?`
+	if err == nil || err.Error() != want {
+		t.Errorf("ReferenceRegistry.ValidateTextTrack returned %v, want %q", err, want)
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	err := &ValidationError{Field: "license", Value: "by-ns", Suggestions: []string{"by-nc", "by-nd", "by-sa"}}
+	want := `invalid license "by-ns", did you mean "by-nc", "by-nd" or "by-sa"?`
+	if err.Error() != want {
+		t.Errorf("ValidationError.Error returned %q, want %q", err.Error(), want)
+	}
+}
+
+func TestReferenceRegistry_Data_retry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/languages", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"error": "Unavailable"}`)
+	})
+
+	reg := NewReferenceRegistry(client, 0)
+	for i := 0; i < 5; i++ {
+		d, err := reg.Data()
+		if err != nil {
+			t.Fatalf("ReferenceRegistry.Data returned unexpected error: %v", err)
+		}
+		if len(d.Languages) == 0 {
+			t.Errorf("ReferenceRegistry.Data returned no languages, want the snapshot")
+		}
+	}
+	if calls != 1 {
+		t.Errorf("ReferenceRegistry.Data loaded languages %d times, want 1", calls)
+	}
+
+	reg.Seed(nil)
+	for i := 0; i < 3; i++ {
+		if _, err := reg.Data(); err == nil {
+			t.Errorf("ReferenceRegistry.Data expected error")
+		}
+	}
+	if calls != 2 {
+		t.Errorf("ReferenceRegistry.Data loaded languages %d times, want 2", calls)
+	}
+}
+
+func TestReferenceRegistry_Refresh_inFlight(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	mux.HandleFunc("/languages", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(started)
+		}
+		<-release
+		fmt.Fprint(w, `{"data": [{"code": "en", "name": "English"}]}`)
+	})
+	mux.HandleFunc("/contentratings", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": [{"code": "safe", "name": "All Audiences"}]}`)
+	})
+	mux.HandleFunc("/creativecommons", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": [{"code": "by", "name": "Attribution"}]}`)
+	})
+
+	reg := NewReferenceRegistry(client, time.Hour)
+	errc := make(chan error, 1)
+	go func() {
+		_, err := reg.Data()
+		errc <- err
+	}()
+
+	<-started
+	refreshed := make(chan error, 1)
+	go func() {
+		refreshed <- reg.Refresh()
+	}()
+	close(release)
+
+	if err := <-errc; err != nil {
+		t.Fatalf("ReferenceRegistry.Data returned unexpected error: %v", err)
+	}
+	if err := <-refreshed; err != nil {
+		t.Fatalf("ReferenceRegistry.Refresh returned unexpected error: %v", err)
+	}
+	if n := atomic.LoadInt32(&calls); n > 2 {
+		t.Errorf("ReferenceRegistry loaded languages %d times, want at most 2", n)
+	}
+}
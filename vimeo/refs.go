@@ -0,0 +1,397 @@
+package vimeo
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const defaultWebURL = "https://vimeo.com/"
+
+// RefKind is the kind of resource a Ref points to.
+type RefKind string
+
+// The kinds of resources a Ref can point to.
+const (
+	RefVideo     RefKind = "video"
+	RefAlbum     RefKind = "album"
+	RefChannel   RefKind = "channel"
+	RefGroup     RefKind = "group"
+	RefUser      RefKind = "user"
+	RefFolder    RefKind = "folder"
+	RefCategory  RefKind = "category"
+	RefLiveEvent RefKind = "live_event"
+)
+
+// Ref is a typed reference to a Vimeo resource, parsed from a web link or an API URI.
+type Ref struct {
+	Kind RefKind
+	// ID is the numeric identifier of the resource, or its slug
+	// for channels, groups, categories and users with a custom URL.
+	ID string
+	// Hash is the privacy hash of an unlisted video.
+	Hash string
+	// Owner is the user who owns an album, folder or live event,
+	// when the link names one. An empty Owner means the authenticated user.
+	Owner string
+	// Parent is the channel, group or album a video link was opened in.
+	Parent *Ref
+}
+
+var (
+	reNumeric    = regexp.MustCompile(`^[0-9]+$`)
+	reHash       = regexp.MustCompile(`^[0-9a-zA-Z]+$`)
+	reNumericUID = regexp.MustCompile(`^user([0-9]+)$`)
+	// reWebHash matches the privacy hash in a vimeo.com link, which is lowercase hex.
+	reWebHash = regexp.MustCompile(`^[0-9a-f]+$`)
+)
+
+// reservedWebPaths are the vimeo.com pages that aren't user profiles.
+var reservedWebPaths = map[string]bool{
+	"about": true, "api": true, "apps": true, "blog": true, "business": true,
+	"cookie_policy": true, "create": true, "enterprise": true, "explore": true,
+	"features": true, "help": true, "home": true, "jobs": true, "join": true,
+	"log_in": true, "login": true, "live": true, "ondemand": true, "ott": true,
+	"partners": true, "pricing": true, "privacy": true, "purchases": true,
+	"search": true, "settings": true, "site_map": true, "solutions": true,
+	"stats": true, "stock": true, "store": true, "terms": true, "upgrade": true,
+	"upload": true, "watch": true, "watchlater": true,
+}
+
+// ParseRef parses a Vimeo web link (vimeo.com, player.vimeo.com), an API URI
+// (such as "/users/1/projects/2" or "https://api.vimeo.com/videos/1") or a bare
+// video identifier ("123" or "123:abcdef") into a Ref.
+func ParseRef(s string) (*Ref, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("empty vimeo reference")
+	}
+
+	if reNumeric.MatchString(s) {
+		return &Ref{Kind: RefVideo, ID: s}, nil
+	}
+
+	if id, hash, ok := splitVideoHash(s); ok {
+		return &Ref{Kind: RefVideo, ID: id, Hash: hash}, nil
+	}
+
+	if !strings.Contains(s, "://") && !strings.HasPrefix(s, "/") {
+		// A link without a scheme starts with a host, a relative API path doesn't.
+		if host := strings.SplitN(s, "/", 2)[0]; strings.Contains(host, ".") {
+			s = "https://" + s
+		} else {
+			s = "/" + s
+		}
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []string
+	for _, seg := range strings.Split(u.Path, "/") {
+		if seg != "" {
+			segments = append(segments, seg)
+		}
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+
+	var ref *Ref
+	switch host {
+	case "", "api.vimeo.com":
+		ref = parseAPIPath(segments)
+	case "vimeo.com":
+		ref = parseWebPath(segments)
+	case "player.vimeo.com":
+		if len(segments) == 2 && segments[0] == "video" && reNumeric.MatchString(segments[1]) {
+			ref = &Ref{Kind: RefVideo, ID: segments[1]}
+		}
+	}
+
+	if ref == nil {
+		return nil, fmt.Errorf("unrecognized vimeo reference %q", s)
+	}
+
+	if h := u.Query().Get("h"); ref.Kind == RefVideo && ref.Hash == "" && reHash.MatchString(h) {
+		ref.Hash = h
+	}
+
+	return ref, nil
+}
+
+func splitVideoHash(s string) (string, string, bool) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 || !reNumeric.MatchString(parts[0]) || !reHash.MatchString(parts[1]) {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+func parseVideoSegment(seg string) *Ref {
+	if reNumeric.MatchString(seg) {
+		return &Ref{Kind: RefVideo, ID: seg}
+	}
+	if id, hash, ok := splitVideoHash(seg); ok {
+		return &Ref{Kind: RefVideo, ID: id, Hash: hash}
+	}
+	return nil
+}
+
+func withParent(ref, parent *Ref) *Ref {
+	if ref != nil {
+		ref.Parent = parent
+	}
+	return ref
+}
+
+func parseWebPath(seg []string) *Ref {
+	switch {
+	case len(seg) == 1 && reNumeric.MatchString(seg[0]):
+		return &Ref{Kind: RefVideo, ID: seg[0]}
+	case len(seg) == 2 && reNumeric.MatchString(seg[0]) && reWebHash.MatchString(seg[1]):
+		return &Ref{Kind: RefVideo, ID: seg[0], Hash: seg[1]}
+	case len(seg) == 0:
+		return nil
+	}
+
+	switch seg[0] {
+	case "showcase", "album":
+		if len(seg) < 2 || !reNumeric.MatchString(seg[1]) {
+			return nil
+		}
+		album := &Ref{Kind: RefAlbum, ID: seg[1]}
+		switch {
+		case len(seg) == 2:
+			return album
+		case len(seg) == 4 && seg[2] == "video":
+			return withParent(parseVideoSegment(seg[3]), album)
+		}
+	case "channels":
+		if len(seg) < 2 {
+			return nil
+		}
+		channel := &Ref{Kind: RefChannel, ID: seg[1]}
+		switch {
+		case len(seg) == 2:
+			return channel
+		case len(seg) == 3:
+			return withParent(parseVideoSegment(seg[2]), channel)
+		case len(seg) == 4 && seg[2] == "videos":
+			return withParent(parseVideoSegment(seg[3]), channel)
+		}
+	case "groups":
+		if len(seg) < 2 {
+			return nil
+		}
+		group := &Ref{Kind: RefGroup, ID: seg[1]}
+		switch {
+		case len(seg) == 2:
+			return group
+		case len(seg) == 4 && seg[2] == "videos":
+			return withParent(parseVideoSegment(seg[3]), group)
+		}
+	case "categories":
+		if len(seg) == 2 {
+			return &Ref{Kind: RefCategory, ID: seg[1]}
+		}
+	case "event":
+		if len(seg) >= 2 && reNumeric.MatchString(seg[1]) {
+			return &Ref{Kind: RefLiveEvent, ID: seg[1]}
+		}
+	case "manage":
+		switch {
+		case len(seg) == 3 && seg[1] == "folders" && reNumeric.MatchString(seg[2]):
+			return &Ref{Kind: RefFolder, ID: seg[2]}
+		case len(seg) >= 3 && seg[1] == "videos" && reNumeric.MatchString(seg[2]):
+			ref := &Ref{Kind: RefVideo, ID: seg[2]}
+			if len(seg) == 4 && reWebHash.MatchString(seg[3]) {
+				ref.Hash = seg[3]
+			}
+			return ref
+		}
+	case "user", "video":
+		return nil
+	default:
+		if reservedWebPaths[strings.ToLower(seg[0])] {
+			return nil
+		}
+		if len(seg) == 1 {
+			if m := reNumericUID.FindStringSubmatch(seg[0]); m != nil {
+				return &Ref{Kind: RefUser, ID: m[1]}
+			}
+			return &Ref{Kind: RefUser, ID: seg[0]}
+		}
+		// Review pages: vimeo.com/{user}/review/{video}/{review hash}
+		if len(seg) >= 3 && seg[1] == "review" && reNumeric.MatchString(seg[2]) {
+			return &Ref{Kind: RefVideo, ID: seg[2]}
+		}
+	}
+
+	return nil
+}
+
+func parseAPIPath(seg []string) *Ref {
+	if len(seg) == 0 {
+		return nil
+	}
+
+	switch seg[0] {
+	case "me":
+		return parseOwnedAPIPath(seg[1:], "")
+	case "users":
+		switch {
+		case len(seg) == 2:
+			return &Ref{Kind: RefUser, ID: seg[1]}
+		case len(seg) > 2:
+			return parseOwnedAPIPath(seg[2:], seg[1])
+		}
+		return nil
+	}
+
+	if len(seg) < 2 {
+		return nil
+	}
+
+	switch seg[0] {
+	case "videos":
+		if len(seg) == 2 {
+			return parseVideoSegment(seg[1])
+		}
+	case "channels":
+		channel := &Ref{Kind: RefChannel, ID: seg[1]}
+		switch {
+		case len(seg) == 2:
+			return channel
+		case len(seg) == 4 && seg[2] == "videos":
+			return withParent(parseVideoSegment(seg[3]), channel)
+		}
+	case "groups":
+		group := &Ref{Kind: RefGroup, ID: seg[1]}
+		switch {
+		case len(seg) == 2:
+			return group
+		case len(seg) == 4 && seg[2] == "videos":
+			return withParent(parseVideoSegment(seg[3]), group)
+		}
+	case "categories":
+		if len(seg) == 2 {
+			return &Ref{Kind: RefCategory, ID: seg[1]}
+		}
+	case "albums":
+		if len(seg) == 2 && reNumeric.MatchString(seg[1]) {
+			return &Ref{Kind: RefAlbum, ID: seg[1]}
+		}
+	case "live_events":
+		if len(seg) == 2 && reNumeric.MatchString(seg[1]) {
+			return &Ref{Kind: RefLiveEvent, ID: seg[1]}
+		}
+	}
+
+	return nil
+}
+
+// parseOwnedAPIPath parses the part of an API URI that follows "/users/{id}" or "/me".
+func parseOwnedAPIPath(seg []string, owner string) *Ref {
+	if len(seg) < 2 {
+		return nil
+	}
+
+	switch seg[0] {
+	case "videos":
+		if len(seg) == 2 {
+			return parseVideoSegment(seg[1])
+		}
+	case "albums":
+		if !reNumeric.MatchString(seg[1]) {
+			return nil
+		}
+		album := &Ref{Kind: RefAlbum, ID: seg[1], Owner: owner}
+		switch {
+		case len(seg) == 2:
+			return album
+		case len(seg) == 4 && seg[2] == "videos":
+			return withParent(parseVideoSegment(seg[3]), album)
+		}
+	case "projects", "folders":
+		if len(seg) == 2 && reNumeric.MatchString(seg[1]) {
+			return &Ref{Kind: RefFolder, ID: seg[1], Owner: owner}
+		}
+	case "live_events":
+		if len(seg) == 2 && reNumeric.MatchString(seg[1]) {
+			return &Ref{Kind: RefLiveEvent, ID: seg[1], Owner: owner}
+		}
+	}
+
+	return nil
+}
+
+func ownerPath(owner string) string {
+	if owner == "" {
+		return "me"
+	}
+	return "users/" + owner
+}
+
+// APIPath returns the API path of the referenced resource, relative to the client BaseURL.
+// Albums, folders and live events without an Owner resolve against the authenticated user.
+func (r *Ref) APIPath() string {
+	switch r.Kind {
+	case RefVideo:
+		if r.Hash != "" {
+			return fmt.Sprintf("videos/%s:%s", r.ID, r.Hash)
+		}
+		return "videos/" + r.ID
+	case RefAlbum:
+		return ownerPath(r.Owner) + "/albums/" + r.ID
+	case RefChannel:
+		return "channels/" + r.ID
+	case RefGroup:
+		return "groups/" + r.ID
+	case RefUser:
+		return "users/" + r.ID
+	case RefFolder:
+		return ownerPath(r.Owner) + "/projects/" + r.ID
+	case RefCategory:
+		return "categories/" + r.ID
+	case RefLiveEvent:
+		return ownerPath(r.Owner) + "/live_events/" + r.ID
+	}
+	return ""
+}
+
+// WebURL returns the canonical vimeo.com link of the referenced resource.
+func (r *Ref) WebURL() string {
+	switch r.Kind {
+	case RefVideo:
+		if r.Hash != "" {
+			return defaultWebURL + r.ID + "/" + r.Hash
+		}
+		return defaultWebURL + r.ID
+	case RefAlbum:
+		return defaultWebURL + "showcase/" + r.ID
+	case RefChannel:
+		return defaultWebURL + "channels/" + r.ID
+	case RefGroup:
+		return defaultWebURL + "groups/" + r.ID
+	case RefUser:
+		if reNumeric.MatchString(r.ID) {
+			return defaultWebURL + "user" + r.ID
+		}
+		return defaultWebURL + r.ID
+	case RefFolder:
+		return defaultWebURL + "manage/folders/" + r.ID
+	case RefCategory:
+		return defaultWebURL + "categories/" + r.ID
+	case RefLiveEvent:
+		return defaultWebURL + "event/" + r.ID
+	}
+	return ""
+}
+
+// String returns the API path of the referenced resource.
+func (r *Ref) String() string {
+	return r.APIPath()
+}
@@ -0,0 +1,212 @@
+package vimeo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRef(t *testing.T) {
+	tests := []struct {
+		in   string
+		want *Ref
+	}{
+		// Bare identifiers
+		{"123", &Ref{Kind: RefVideo, ID: "123"}},
+		{"123:abcdef", &Ref{Kind: RefVideo, ID: "123", Hash: "abcdef"}},
+		{" 123 ", &Ref{Kind: RefVideo, ID: "123"}},
+
+		// Video links
+		{"https://vimeo.com/123", &Ref{Kind: RefVideo, ID: "123"}},
+		{"http://vimeo.com/123", &Ref{Kind: RefVideo, ID: "123"}},
+		{"vimeo.com/123", &Ref{Kind: RefVideo, ID: "123"}},
+		{"www.vimeo.com/123", &Ref{Kind: RefVideo, ID: "123"}},
+		{"https://www.vimeo.com/123/", &Ref{Kind: RefVideo, ID: "123"}},
+		{"https://vimeo.com/123?autoplay=1", &Ref{Kind: RefVideo, ID: "123"}},
+		{"https://vimeo.com/123#t=30s", &Ref{Kind: RefVideo, ID: "123"}},
+		{"https://vimeo.com/123/abcdef", &Ref{Kind: RefVideo, ID: "123", Hash: "abcdef"}},
+		{"vimeo.com/123/abcdef", &Ref{Kind: RefVideo, ID: "123", Hash: "abcdef"}},
+		{"https://vimeo.com/123?h=abcdef", &Ref{Kind: RefVideo, ID: "123", Hash: "abcdef"}},
+		{"https://player.vimeo.com/video/123", &Ref{Kind: RefVideo, ID: "123"}},
+		{"https://player.vimeo.com/video/123?h=abcdef", &Ref{Kind: RefVideo, ID: "123", Hash: "abcdef"}},
+		{"https://player.vimeo.com/video/123?h=abcdef&badge=0&autopause=0", &Ref{Kind: RefVideo, ID: "123", Hash: "abcdef"}},
+		{"player.vimeo.com/video/123?h=abcdef", &Ref{Kind: RefVideo, ID: "123", Hash: "abcdef"}},
+		{"https://vimeo.com/manage/videos/123", &Ref{Kind: RefVideo, ID: "123"}},
+		{"https://vimeo.com/manage/videos/123/abcdef", &Ref{Kind: RefVideo, ID: "123", Hash: "abcdef"}},
+		{"https://vimeo.com/staff/review/123/9f8e7d6c5b", &Ref{Kind: RefVideo, ID: "123"}},
+
+		// Videos opened in a showcase, channel or group
+		{"https://vimeo.com/showcase/456/video/123", &Ref{Kind: RefVideo, ID: "123", Parent: &Ref{Kind: RefAlbum, ID: "456"}}},
+		{"https://vimeo.com/channels/staffpicks/789", &Ref{Kind: RefVideo, ID: "789", Parent: &Ref{Kind: RefChannel, ID: "staffpicks"}}},
+		{"https://vimeo.com/channels/staffpicks/videos/789", &Ref{Kind: RefVideo, ID: "789", Parent: &Ref{Kind: RefChannel, ID: "staffpicks"}}},
+		{"https://vimeo.com/groups/name/videos/1", &Ref{Kind: RefVideo, ID: "1", Parent: &Ref{Kind: RefGroup, ID: "name"}}},
+
+		// Other web links
+		{"https://vimeo.com/showcase/456", &Ref{Kind: RefAlbum, ID: "456"}},
+		{"https://vimeo.com/album/456", &Ref{Kind: RefAlbum, ID: "456"}},
+		{"https://vimeo.com/channels/staffpicks", &Ref{Kind: RefChannel, ID: "staffpicks"}},
+		{"https://vimeo.com/channels/927", &Ref{Kind: RefChannel, ID: "927"}},
+		{"https://vimeo.com/groups/name", &Ref{Kind: RefGroup, ID: "name"}},
+		{"https://vimeo.com/categories/animation", &Ref{Kind: RefCategory, ID: "animation"}},
+		{"https://vimeo.com/event/42", &Ref{Kind: RefLiveEvent, ID: "42"}},
+		{"https://vimeo.com/event/42/embed", &Ref{Kind: RefLiveEvent, ID: "42"}},
+		{"https://vimeo.com/manage/folders/77", &Ref{Kind: RefFolder, ID: "77"}},
+		{"https://vimeo.com/staff", &Ref{Kind: RefUser, ID: "staff"}},
+		{"https://vimeo.com/user152184", &Ref{Kind: RefUser, ID: "152184"}},
+		{"https://vimeo.com/aboutface", &Ref{Kind: RefUser, ID: "aboutface"}},
+
+		// API URIs
+		{"/videos/123", &Ref{Kind: RefVideo, ID: "123"}},
+		{"/videos/123:abcdef", &Ref{Kind: RefVideo, ID: "123", Hash: "abcdef"}},
+		{"videos/123", &Ref{Kind: RefVideo, ID: "123"}},
+		{"https://api.vimeo.com/videos/123", &Ref{Kind: RefVideo, ID: "123"}},
+		{"/users/1/videos/123", &Ref{Kind: RefVideo, ID: "123"}},
+		{"/me/videos/123", &Ref{Kind: RefVideo, ID: "123"}},
+		{"/users/1", &Ref{Kind: RefUser, ID: "1"}},
+		{"/users/1/albums/456", &Ref{Kind: RefAlbum, ID: "456", Owner: "1"}},
+		{"/me/albums/456", &Ref{Kind: RefAlbum, ID: "456"}},
+		{"/albums/456", &Ref{Kind: RefAlbum, ID: "456"}},
+		{"/users/1/albums/456/videos/123", &Ref{Kind: RefVideo, ID: "123", Parent: &Ref{Kind: RefAlbum, ID: "456", Owner: "1"}}},
+		{"/users/1/projects/2", &Ref{Kind: RefFolder, ID: "2", Owner: "1"}},
+		{"/me/projects/2", &Ref{Kind: RefFolder, ID: "2"}},
+		{"/users/1/folders/2", &Ref{Kind: RefFolder, ID: "2", Owner: "1"}},
+		{"/users/1/live_events/3", &Ref{Kind: RefLiveEvent, ID: "3", Owner: "1"}},
+		{"/live_events/3", &Ref{Kind: RefLiveEvent, ID: "3"}},
+		{"/channels/staffpicks", &Ref{Kind: RefChannel, ID: "staffpicks"}},
+		{"/channels/staffpicks/videos/789", &Ref{Kind: RefVideo, ID: "789", Parent: &Ref{Kind: RefChannel, ID: "staffpicks"}}},
+		{"/groups/name", &Ref{Kind: RefGroup, ID: "name"}},
+		{"/groups/name/videos/1", &Ref{Kind: RefVideo, ID: "1", Parent: &Ref{Kind: RefGroup, ID: "name"}}},
+		{"/categories/animation", &Ref{Kind: RefCategory, ID: "animation"}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseRef(tt.in)
+		if err != nil {
+			t.Errorf("ParseRef(%q) returned unexpected error: %v", tt.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseRef(%q) returned %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseRef_invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"abc",
+		"123:",
+		"https://example.com/123",
+		"https://vimeo.com/",
+		"https://vimeo.com/showcase",
+		"https://vimeo.com/showcase/abc",
+		"https://vimeo.com/channels",
+		"https://vimeo.com/groups/name/members",
+		"https://player.vimeo.com/video/abc",
+		"/videos",
+		"/videos/abc",
+		"/videos/123/pictures/1",
+		"/me",
+		"/users/1/projects/abc",
+		"/unknown/1",
+		"https://vimeo.com/123/likes",
+		"https://vimeo.com/123/ABCDEF",
+		"https://vimeo.com/about",
+		"https://vimeo.com/watch",
+		"https://vimeo.com/upload",
+		"https://vimeo.com/Pricing",
+		"https://vimeo.com/help/review/123/abcdef",
+	}
+
+	for _, in := range tests {
+		if got, err := ParseRef(in); err == nil {
+			t.Errorf("ParseRef(%q) returned %+v, want error", in, got)
+		}
+	}
+}
+
+func TestRef_APIPath(t *testing.T) {
+	tests := []struct {
+		ref  *Ref
+		want string
+	}{
+		{&Ref{Kind: RefVideo, ID: "123"}, "videos/123"},
+		{&Ref{Kind: RefVideo, ID: "123", Hash: "abcdef"}, "videos/123:abcdef"},
+		{&Ref{Kind: RefVideo, ID: "789", Parent: &Ref{Kind: RefChannel, ID: "staffpicks"}}, "videos/789"},
+		{&Ref{Kind: RefAlbum, ID: "456"}, "me/albums/456"},
+		{&Ref{Kind: RefAlbum, ID: "456", Owner: "1"}, "users/1/albums/456"},
+		{&Ref{Kind: RefChannel, ID: "staffpicks"}, "channels/staffpicks"},
+		{&Ref{Kind: RefGroup, ID: "name"}, "groups/name"},
+		{&Ref{Kind: RefUser, ID: "staff"}, "users/staff"},
+		{&Ref{Kind: RefFolder, ID: "2"}, "me/projects/2"},
+		{&Ref{Kind: RefFolder, ID: "2", Owner: "1"}, "users/1/projects/2"},
+		{&Ref{Kind: RefCategory, ID: "animation"}, "categories/animation"},
+		{&Ref{Kind: RefLiveEvent, ID: "3"}, "me/live_events/3"},
+		{&Ref{Kind: RefLiveEvent, ID: "3", Owner: "1"}, "users/1/live_events/3"},
+		{&Ref{Kind: "unknown", ID: "1"}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.ref.APIPath(); got != tt.want {
+			t.Errorf("Ref.APIPath(%+v) returned %v, want %v", tt.ref, got, tt.want)
+		}
+	}
+}
+
+func TestRef_WebURL(t *testing.T) {
+	tests := []struct {
+		ref  *Ref
+		want string
+	}{
+		{&Ref{Kind: RefVideo, ID: "123"}, "https://vimeo.com/123"},
+		{&Ref{Kind: RefVideo, ID: "123", Hash: "abcdef"}, "https://vimeo.com/123/abcdef"},
+		{&Ref{Kind: RefAlbum, ID: "456", Owner: "1"}, "https://vimeo.com/showcase/456"},
+		{&Ref{Kind: RefChannel, ID: "staffpicks"}, "https://vimeo.com/channels/staffpicks"},
+		{&Ref{Kind: RefGroup, ID: "name"}, "https://vimeo.com/groups/name"},
+		{&Ref{Kind: RefUser, ID: "staff"}, "https://vimeo.com/staff"},
+		{&Ref{Kind: RefUser, ID: "152184"}, "https://vimeo.com/user152184"},
+		{&Ref{Kind: RefFolder, ID: "2"}, "https://vimeo.com/manage/folders/2"},
+		{&Ref{Kind: RefCategory, ID: "animation"}, "https://vimeo.com/categories/animation"},
+		{&Ref{Kind: RefLiveEvent, ID: "3"}, "https://vimeo.com/event/3"},
+		{&Ref{Kind: "unknown", ID: "1"}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.ref.WebURL(); got != tt.want {
+			t.Errorf("Ref.WebURL(%+v) returned %v, want %v", tt.ref, got, tt.want)
+		}
+	}
+}
+
+func TestRef_roundTrip(t *testing.T) {
+	refs := []*Ref{
+		{Kind: RefVideo, ID: "123"},
+		{Kind: RefVideo, ID: "123", Hash: "abcdef"},
+		{Kind: RefAlbum, ID: "456"},
+		{Kind: RefChannel, ID: "staffpicks"},
+		{Kind: RefGroup, ID: "name"},
+		{Kind: RefUser, ID: "152184"},
+		{Kind: RefFolder, ID: "2"},
+		{Kind: RefCategory, ID: "animation"},
+		{Kind: RefLiveEvent, ID: "3"},
+	}
+
+	for _, ref := range refs {
+		fromWeb, err := ParseRef(ref.WebURL())
+		if err != nil || !reflect.DeepEqual(fromWeb, ref) {
+			t.Errorf("ParseRef(%q) returned %+v, %v, want %+v", ref.WebURL(), fromWeb, err, ref)
+		}
+
+		fromAPI, err := ParseRef("/" + ref.APIPath())
+		if err != nil || !reflect.DeepEqual(fromAPI, ref) {
+			t.Errorf("ParseRef(%q) returned %+v, %v, want %+v", "/"+ref.APIPath(), fromAPI, err, ref)
+		}
+	}
+}
+
+func TestVideo_GetID_unlisted(t *testing.T) {
+	v := &Video{URI: "/videos/123:abcdef"}
+
+	if id := v.GetID(); id != 123 {
+		t.Errorf("Video.GetID returned %+v, want %+v", id, 123)
+	}
+}
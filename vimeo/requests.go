@@ -0,0 +1,319 @@
+package vimeo
+
+// String returns a pointer to the string value v.
+// It is a helper for optional fields of request types.
+func String(v string) *string { return &v }
+
+// Bool returns a pointer to the bool value v.
+// It is a helper for optional fields of request types.
+func Bool(v bool) *bool { return &v }
+
+// Int returns a pointer to the int value v.
+// It is a helper for optional fields of request types.
+func Int(v int) *int { return &v }
+
+// Float32 returns a pointer to the float32 value v.
+// It is a helper for optional fields of request types.
+func Float32(v float32) *float32 { return &v }
+
+// NewVideoRequest returns an empty VideoRequest. Only the fields set
+// with the Set methods are sent to the API.
+//
+//	r := vimeo.NewVideoRequest().SetName("Intro").SetEmbed(vimeo.NewEmbedRequest().SetColor("ff0000"))
+func NewVideoRequest() *VideoRequest {
+	return &VideoRequest{}
+}
+
+// SetName sets the name of the video.
+func (r *VideoRequest) SetName(v string) *VideoRequest {
+	r.Name = &v
+	return r
+}
+
+// SetDescription sets the description of the video.
+func (r *VideoRequest) SetDescription(v string) *VideoRequest {
+	r.Description = &v
+	return r
+}
+
+// SetLicense sets the Creative Commons license of the video.
+func (r *VideoRequest) SetLicense(v License) *VideoRequest {
+	r.License = &v
+	return r
+}
+
+// SetPassword sets the password of the video.
+func (r *VideoRequest) SetPassword(v string) *VideoRequest {
+	r.Password = &v
+	return r
+}
+
+// SetLocale sets the default language of the video.
+func (r *VideoRequest) SetLocale(v string) *VideoRequest {
+	r.Locale = &v
+	return r
+}
+
+// SetContentRating sets the content ratings of the video.
+func (r *VideoRequest) SetContentRating(v ...ContentRatingCode) *VideoRequest {
+	r.ContentRating = v
+	return r
+}
+
+// SetPrivacy sets the privacy settings of the video.
+func (r *VideoRequest) SetPrivacy(v *PrivacyRequest) *VideoRequest {
+	r.Privacy = v
+	return r
+}
+
+// SetEmbed sets the embed settings of the video.
+func (r *VideoRequest) SetEmbed(v *EmbedRequest) *VideoRequest {
+	r.Embed = v
+	return r
+}
+
+// SetReviewPage enables or disables the review page of the video.
+func (r *VideoRequest) SetReviewPage(active bool) *VideoRequest {
+	r.ReviewPage = &ReviewPageRequest{Active: &active}
+	return r
+}
+
+// NewEmbedRequest returns an empty EmbedRequest. Only the fields set
+// with the Set methods are sent to the API.
+func NewEmbedRequest() *EmbedRequest {
+	return &EmbedRequest{}
+}
+
+// SetColor sets the main color of the embeddable player.
+func (r *EmbedRequest) SetColor(v string) *EmbedRequest {
+	r.Color = &v
+	return r
+}
+
+// SetBadge shows or hides the badge of the embeddable player.
+func (r *EmbedRequest) SetBadge(v bool) *EmbedRequest {
+	r.Badge = &v
+	return r
+}
+
+// SetByLine sets how the byline is shown in the embeddable player.
+func (r *EmbedRequest) SetByLine(v string) *EmbedRequest {
+	r.ByLine = &v
+	return r
+}
+
+// SetPortrait sets how the portrait is shown in the embeddable player.
+func (r *EmbedRequest) SetPortrait(v string) *EmbedRequest {
+	r.Portrait = &v
+	return r
+}
+
+// SetOutro sets the outro type of the embeddable player.
+func (r *EmbedRequest) SetOutro(v string) *EmbedRequest {
+	r.Outro = &v
+	return r
+}
+
+// SetTitle sets the title settings of the embeddable player.
+func (r *EmbedRequest) SetTitle(v *TitleRequest) *EmbedRequest {
+	r.Title = v
+	return r
+}
+
+// SetButtons sets the buttons of the embeddable player.
+func (r *EmbedRequest) SetButtons(v *Buttons) *EmbedRequest {
+	r.Buttons = v
+	return r
+}
+
+// SetLogos sets the logos of the embeddable player.
+func (r *EmbedRequest) SetLogos(v *Logos) *EmbedRequest {
+	r.Logos = v
+	return r
+}
+
+// SetPlayBar shows or hides the play bar of the embeddable player.
+func (r *EmbedRequest) SetPlayBar(v bool) *EmbedRequest {
+	r.PlayBar = &v
+	return r
+}
+
+// SetVolume shows or hides the volume control of the embeddable player.
+func (r *EmbedRequest) SetVolume(v bool) *EmbedRequest {
+	r.Volume = &v
+	return r
+}
+
+// SetAutoplay enables or disables autoplay of the embeddable player.
+func (r *EmbedRequest) SetAutoplay(v bool) *EmbedRequest {
+	r.Autoplay = &v
+	return r
+}
+
+// SetAutopause enables or disables autopause of the embeddable player.
+func (r *EmbedRequest) SetAutopause(v bool) *EmbedRequest {
+	r.Autopause = &v
+	return r
+}
+
+// SetLoop enables or disables looping of the embeddable player.
+func (r *EmbedRequest) SetLoop(v bool) *EmbedRequest {
+	r.Loop = &v
+	return r
+}
+
+// SetLink enables or disables the link to the video in the embeddable player.
+func (r *EmbedRequest) SetLink(v bool) *EmbedRequest {
+	r.Link = &v
+	return r
+}
+
+// SetView sets who can view the video.
+func (r *PrivacyRequest) SetView(v ViewPrivacy) *PrivacyRequest {
+	r.View = &v
+	return r
+}
+
+// SetEmbed sets where the video can be embedded.
+func (r *PrivacyRequest) SetEmbed(v EmbedPrivacy) *PrivacyRequest {
+	r.Embed = &v
+	return r
+}
+
+// SetComment sets who can comment on the video.
+func (r *PrivacyRequest) SetComment(v CommentPrivacy) *PrivacyRequest {
+	r.Comment = &v
+	return r
+}
+
+// SetDownload sets whether the video can be downloaded.
+func (r *PrivacyRequest) SetDownload(v bool) *PrivacyRequest {
+	r.Download = &v
+	return r
+}
+
+// SetAdd sets whether the video can be added to collections.
+func (r *PrivacyRequest) SetAdd(v bool) *PrivacyRequest {
+	r.Add = &v
+	return r
+}
+
+// SetName sets the name of the album.
+func (r *AlbumRequest) SetName(v string) *AlbumRequest {
+	r.Name = &v
+	return r
+}
+
+// SetDescription sets the description of the album.
+func (r *AlbumRequest) SetDescription(v string) *AlbumRequest {
+	r.Description = &v
+	return r
+}
+
+// SetPrivacy sets the privacy of the album.
+func (r *AlbumRequest) SetPrivacy(v AlbumView) *AlbumRequest {
+	r.Privacy = &v
+	return r
+}
+
+// SetPassword sets the password of the album.
+func (r *AlbumRequest) SetPassword(v string) *AlbumRequest {
+	r.Password = &v
+	return r
+}
+
+// SetSort sets the default sort order of the album.
+func (r *AlbumRequest) SetSort(v AlbumSort) *AlbumRequest {
+	r.Sort = &v
+	return r
+}
+
+// SetLayout sets the layout of the album, "grid" or "player".
+func (r *AlbumRequest) SetLayout(v string) *AlbumRequest {
+	r.Layout = &v
+	return r
+}
+
+// SetTheme sets the color theme of the album, "standard" or "dark".
+func (r *AlbumRequest) SetTheme(v string) *AlbumRequest {
+	r.Theme = &v
+	return r
+}
+
+// SetBrandColor sets the hexadecimal brand color of the album.
+func (r *AlbumRequest) SetBrandColor(v string) *AlbumRequest {
+	r.BrandColor = &v
+	return r
+}
+
+// SetHideNav shows or hides the navigation of the album.
+func (r *AlbumRequest) SetHideNav(v bool) *AlbumRequest {
+	r.HideNav = &v
+	return r
+}
+
+// SetReviewMode enables or disables the review mode of the album.
+func (r *AlbumRequest) SetReviewMode(v bool) *AlbumRequest {
+	r.ReviewMode = &v
+	return r
+}
+
+// SetURL sets the custom URL of the album.
+func (r *AlbumRequest) SetURL(v string) *AlbumRequest {
+	r.URL = &v
+	return r
+}
+
+// SetDomain sets the custom domain of the album.
+func (r *AlbumRequest) SetDomain(v string) *AlbumRequest {
+	r.Domain = &v
+	return r
+}
+
+// SetName sets the name of the channel.
+func (r *ChannelRequest) SetName(v string) *ChannelRequest {
+	r.Name = &v
+	return r
+}
+
+// SetDescription sets the description of the channel.
+func (r *ChannelRequest) SetDescription(v string) *ChannelRequest {
+	r.Description = &v
+	return r
+}
+
+// SetPrivacy sets the privacy of the channel.
+func (r *ChannelRequest) SetPrivacy(v ChannelView) *ChannelRequest {
+	r.Privacy = &v
+	return r
+}
+
+// SetName sets the name of the user.
+func (r *UserRequest) SetName(v string) *UserRequest {
+	r.Name = &v
+	return r
+}
+
+// SetLocation sets the location of the user.
+func (r *UserRequest) SetLocation(v string) *UserRequest {
+	r.Location = &v
+	return r
+}
+
+// SetBio sets the bio of the user.
+func (r *UserRequest) SetBio(v string) *UserRequest {
+	r.Bio = &v
+	return r
+}
+
+// SetName sets the name of the group.
+func (r *GroupRequest) SetName(v string) *GroupRequest {
+	r.Name = &v
+	return r
+}
+
+// SetDescription sets the description of the group.
+func (r *GroupRequest) SetDescription(v string) *GroupRequest {
+	r.Description = &v
+	return r
+}
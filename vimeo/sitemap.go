@@ -0,0 +1,396 @@
+package vimeo
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	// sitemapMaxURLs is the largest number of URLs a sitemap file may hold.
+	sitemapMaxURLs = 50000
+	// sitemapMaxTags is the largest number of tags a video entry may hold.
+	sitemapMaxTags = 32
+	// sitemapMaxDescription is the longest description a video entry may hold.
+	sitemapMaxDescription = 2048
+
+	sitemapXMLNS      = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	sitemapVideoXMLNS = "http://www.google.com/schemas/sitemap-video/1.1"
+)
+
+// VideoSitemap builds Google video sitemaps from videos, split into files of at most MaxURLs entries.
+// Only public videos are added.
+//
+// Google docs: https://developers.google.com/search/docs/crawling-indexing/sitemaps/video-sitemaps
+type VideoSitemap struct {
+	// MaxURLs is the number of entries per file, 50000 when zero.
+	MaxURLs int
+	// Loc returns the address of the page that shows the video, the video link when nil.
+	Loc func(v *Video) string
+
+	files [][]*Video
+}
+
+// Add adds a video to the sitemap. It returns false if the video is skipped
+// because it isn't public or has no thumbnail.
+func (s *VideoSitemap) Add(v *Video) bool {
+	if !sitemapIncluded(v) {
+		return false
+	}
+
+	max := s.MaxURLs
+	if max <= 0 || max > sitemapMaxURLs {
+		max = sitemapMaxURLs
+	}
+
+	if n := len(s.files); n == 0 || len(s.files[n-1]) >= max {
+		s.files = append(s.files, nil)
+	}
+	s.files[len(s.files)-1] = append(s.files[len(s.files)-1], v)
+	return true
+}
+
+// AddAll adds the videos to the sitemap.
+func (s *VideoSitemap) AddAll(videos []*Video) {
+	for _, v := range videos {
+		s.Add(v)
+	}
+}
+
+// AddIterator adds every video of the iterator to the sitemap.
+func (s *VideoSitemap) AddIterator(it *Iterator[Video]) error {
+	for it.Next() {
+		s.Add(it.Value())
+	}
+	return it.Err()
+}
+
+// Len returns the number of sitemap files.
+func (s *VideoSitemap) Len() int {
+	return len(s.files)
+}
+
+func sitemapIncluded(v *Video) bool {
+	if v == nil || v.Link == "" || sitemapThumbnail(v) == "" {
+		return false
+	}
+	return v.Privacy == nil || v.Privacy.View == ViewAnybody
+}
+
+func sitemapThumbnail(v *Video) string {
+	if v.Pictures == nil {
+		return ""
+	}
+	var best *PictureSize
+	for _, size := range v.Pictures.Sizes {
+		if best == nil || size.Width > best.Width {
+			best = size
+		}
+	}
+	if best == nil {
+		return ""
+	}
+	return best.Link
+}
+
+func sitemapPublished(v *Video) time.Time {
+	if !v.ReleaseTime.IsZero() {
+		return v.ReleaseTime
+	}
+	return v.CreatedTime
+}
+
+func sitemapDescription(v *Video) string {
+	d := v.Description
+	if d == "" {
+		d = v.Name
+	}
+	if r := []rune(d); len(r) > sitemapMaxDescription {
+		d = string(r[:sitemapMaxDescription])
+	}
+	return d
+}
+
+func sitemapTags(v *Video) []string {
+	var tags []string
+	for _, t := range v.Tags {
+		if len(tags) == sitemapMaxTags {
+			break
+		}
+		if t.Name != "" {
+			tags = append(tags, t.Name)
+		} else if t.Tag != "" {
+			tags = append(tags, t.Tag)
+		}
+	}
+	return tags
+}
+
+// familyFriendly reports whether the content rating of the video is safe for all audiences.
+// The second result is false for a video that hasn't been rated.
+func familyFriendly(v *Video) (bool, bool) {
+	if len(v.ContentRating) == 0 {
+		return false, false
+	}
+	for _, r := range v.ContentRating {
+		switch r {
+		case RatingSafe:
+		case RatingUnrated:
+			return false, false
+		default:
+			return false, true
+		}
+	}
+	return true, true
+}
+
+func embeddable(v *Video) bool {
+	return v.Privacy == nil || v.Privacy.Embed != EmbedPrivate
+}
+
+type sitemapURLSet struct {
+	XMLName    xml.Name      `xml:"urlset"`
+	XMLNS      string        `xml:"xmlns,attr"`
+	XMLNSVideo string        `xml:"xmlns:video,attr"`
+	URLs       []*sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc   string        `xml:"loc"`
+	Video *sitemapVideo `xml:"video:video"`
+}
+
+type sitemapUploader struct {
+	Name string `xml:",chardata"`
+	Info string `xml:"info,attr,omitempty"`
+}
+
+type sitemapVideo struct {
+	ThumbnailLoc    string           `xml:"video:thumbnail_loc"`
+	Title           string           `xml:"video:title"`
+	Description     string           `xml:"video:description"`
+	PlayerLoc       string           `xml:"video:player_loc,omitempty"`
+	Duration        int              `xml:"video:duration,omitempty"`
+	ViewCount       int              `xml:"video:view_count,omitempty"`
+	PublicationDate string           `xml:"video:publication_date,omitempty"`
+	FamilyFriendly  string           `xml:"video:family_friendly,omitempty"`
+	Tags            []string         `xml:"video:tag"`
+	Uploader        *sitemapUploader `xml:"video:uploader,omitempty"`
+	Live            string           `xml:"video:live,omitempty"`
+}
+
+func (s *VideoSitemap) url(v *Video) *sitemapURL {
+	loc := v.Link
+	if s.Loc != nil {
+		loc = s.Loc(v)
+	}
+
+	e := &sitemapVideo{
+		ThumbnailLoc: sitemapThumbnail(v),
+		Title:        v.Name,
+		Description:  sitemapDescription(v),
+		Duration:     v.Duration,
+		Tags:         sitemapTags(v),
+	}
+
+	if embeddable(v) {
+		if id := v.GetVideoID(); id.ID != 0 {
+			e.PlayerLoc = id.PlayerURL(nil)
+		}
+	}
+	if v.Stats != nil {
+		e.ViewCount = v.Stats.Plays
+	}
+	if t := sitemapPublished(v); !t.IsZero() {
+		e.PublicationDate = t.Format(time.RFC3339)
+	}
+	if ff, rated := familyFriendly(v); rated {
+		if ff {
+			e.FamilyFriendly = "yes"
+		} else {
+			e.FamilyFriendly = "no"
+		}
+	}
+	if v.User != nil && v.User.Name != "" {
+		e.Uploader = &sitemapUploader{Name: v.User.Name, Info: v.User.Link}
+	}
+	if v.Type == "live" {
+		e.Live = "yes"
+	}
+
+	return &sitemapURL{Loc: loc, Video: e}
+}
+
+// WriteFile writes the i-th sitemap file to w.
+func (s *VideoSitemap) WriteFile(i int, w io.Writer) error {
+	if i < 0 || i >= len(s.files) {
+		return fmt.Errorf("sitemap %d out of range [0, %d)", i, len(s.files))
+	}
+
+	set := &sitemapURLSet{XMLNS: sitemapXMLNS, XMLNSVideo: sitemapVideoXMLNS}
+	for _, v := range s.files[i] {
+		set.URLs = append(set.URLs, s.url(v))
+	}
+
+	return writeXML(w, set)
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name        `xml:"sitemapindex"`
+	XMLNS    string          `xml:"xmlns,attr"`
+	Sitemaps []*sitemapEntry `xml:"sitemap"`
+}
+
+type sitemapEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// WriteIndex writes the sitemap index to w. The loc function returns the address
+// the i-th sitemap file is published at. The last modification of a file is the
+// latest modification of its videos.
+func (s *VideoSitemap) WriteIndex(w io.Writer, loc func(i int) string) error {
+	index := &sitemapIndex{XMLNS: sitemapXMLNS}
+	for i, videos := range s.files {
+		var last time.Time
+		for _, v := range videos {
+			if v.ModifiedTime.After(last) {
+				last = v.ModifiedTime
+			}
+		}
+
+		e := &sitemapEntry{Loc: loc(i)}
+		if !last.IsZero() {
+			e.LastMod = last.Format(time.RFC3339)
+		}
+		index.Sitemaps = append(index.Sitemaps, e)
+	}
+
+	return writeXML(w, index)
+}
+
+func writeXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// VideoObject represents a schema.org VideoObject, for JSON-LD structured data.
+//
+// Schema docs: https://schema.org/VideoObject
+type VideoObject struct {
+	Context              string              `json:"@context"`
+	Type                 string              `json:"@type"`
+	Name                 string              `json:"name"`
+	Description          string              `json:"description"`
+	ThumbnailURL         []string            `json:"thumbnailUrl,omitempty"`
+	UploadDate           string              `json:"uploadDate,omitempty"`
+	Duration             string              `json:"duration,omitempty"`
+	EmbedURL             string              `json:"embedUrl,omitempty"`
+	URL                  string              `json:"url,omitempty"`
+	Keywords             string              `json:"keywords,omitempty"`
+	IsFamilyFriendly     *bool               `json:"isFamilyFriendly,omitempty"`
+	Width                int                 `json:"width,omitempty"`
+	Height               int                 `json:"height,omitempty"`
+	Author               *VideoObjectPerson  `json:"author,omitempty"`
+	InteractionStatistic *InteractionCounter `json:"interactionStatistic,omitempty"`
+}
+
+// VideoObjectPerson represents a schema.org Person.
+type VideoObjectPerson struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+// InteractionCounter represents a schema.org InteractionCounter.
+type InteractionCounter struct {
+	Type                 string `json:"@type"`
+	InteractionType      string `json:"interactionType"`
+	UserInteractionCount int    `json:"userInteractionCount"`
+}
+
+// NewVideoObject returns the schema.org VideoObject of the video.
+func NewVideoObject(v *Video) *VideoObject {
+	o := &VideoObject{
+		Context:     "https://schema.org",
+		Type:        "VideoObject",
+		Name:        v.Name,
+		Description: sitemapDescription(v),
+		URL:         v.Link,
+		Keywords:    strings.Join(sitemapTags(v), ","),
+		Width:       v.Width,
+		Height:      v.Height,
+	}
+
+	if v.Pictures != nil {
+		for _, size := range v.Pictures.Sizes {
+			o.ThumbnailURL = append(o.ThumbnailURL, size.Link)
+		}
+	}
+	if t := sitemapPublished(v); !t.IsZero() {
+		o.UploadDate = t.Format(time.RFC3339)
+	}
+	if v.Duration > 0 {
+		o.Duration = isoDuration(v.Duration)
+	}
+	if embeddable(v) {
+		if id := v.GetVideoID(); id.ID != 0 {
+			o.EmbedURL = id.PlayerURL(nil)
+		}
+	}
+	if ff, rated := familyFriendly(v); rated {
+		o.IsFamilyFriendly = &ff
+	}
+	if v.User != nil && v.User.Name != "" {
+		o.Author = &VideoObjectPerson{Type: "Person", Name: v.User.Name, URL: v.User.Link}
+	}
+	if v.Stats != nil {
+		o.InteractionStatistic = &InteractionCounter{
+			Type:                 "InteractionCounter",
+			InteractionType:      "https://schema.org/WatchAction",
+			UserInteractionCount: v.Stats.Plays,
+		}
+	}
+
+	return o
+}
+
+// JSONLD returns the script element that embeds the VideoObject in a page.
+func (o *VideoObject) JSONLD() (string, error) {
+	b, err := json.Marshal(o)
+	if err != nil {
+		return "", err
+	}
+	// json.Marshal escapes "<", ">" and "&", so the data can't close the script element.
+	return `<script type="application/ld+json">` + string(b) + `</script>`, nil
+}
+
+// isoDuration formats seconds as an ISO 8601 duration, such as PT1H2M3S.
+func isoDuration(seconds int) string {
+	h, m, s := seconds/3600, seconds%3600/60, seconds%60
+
+	d := "PT"
+	if h > 0 {
+		d += fmt.Sprintf("%dH", h)
+	}
+	if m > 0 {
+		d += fmt.Sprintf("%dM", m)
+	}
+	if s > 0 || (h == 0 && m == 0) {
+		d += fmt.Sprintf("%dS", s)
+	}
+	return d
+}
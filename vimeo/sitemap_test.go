@@ -0,0 +1,124 @@
+package vimeo
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func sitemapTestVideo(id int) *Video {
+	return &Video{
+		URI:           fmt.Sprintf("/videos/%d", id),
+		Name:          fmt.Sprintf("Video <%d>", id),
+		Link:          fmt.Sprintf("https://vimeo.com/%d", id),
+		Duration:      3723,
+		ContentRating: []ContentRatingCode{RatingSafe},
+		CreatedTime:   time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		ModifiedTime:  time.Date(2021, 1, 1, 0, 0, id, 0, time.UTC),
+		Privacy:       &VideoPrivacy{View: ViewAnybody, Embed: EmbedPublic},
+		Pictures: &Pictures{Sizes: []*PictureSize{
+			{Width: 100, Link: "https://i.vimeocdn.com/small.jpg"},
+			{Width: 1280, Link: "https://i.vimeocdn.com/large.jpg"},
+		}},
+		Tags:  []*Tag{{Name: "go"}, {Name: "vimeo"}},
+		Stats: &Stats{Plays: 42},
+		User:  &User{Name: "Staff", Link: "https://vimeo.com/staff"},
+	}
+}
+
+func TestVideoSitemap_WriteFile(t *testing.T) {
+	s := &VideoSitemap{}
+
+	private := sitemapTestVideo(2)
+	private.Privacy.View = "nobody"
+
+	if !s.Add(sitemapTestVideo(1)) {
+		t.Error("VideoSitemap.Add skipped a public video")
+	}
+	if s.Add(private) {
+		t.Error("VideoSitemap.Add added a private video")
+	}
+
+	var buf bytes.Buffer
+	if err := s.WriteFile(0, &buf); err != nil {
+		t.Fatalf("VideoSitemap.WriteFile returned unexpected error: %v", err)
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9" xmlns:video="http://www.google.com/schemas/sitemap-video/1.1">
+  <url>
+    <loc>https://vimeo.com/1</loc>
+    <video:video>
+      <video:thumbnail_loc>https://i.vimeocdn.com/large.jpg</video:thumbnail_loc>
+      <video:title>Video &lt;1&gt;</video:title>
+      <video:description>Video &lt;1&gt;</video:description>
+      <video:player_loc>https://player.vimeo.com/video/1</video:player_loc>
+      <video:duration>3723</video:duration>
+      <video:view_count>42</video:view_count>
+      <video:publication_date>2020-01-02T03:04:05Z</video:publication_date>
+      <video:family_friendly>yes</video:family_friendly>
+      <video:tag>go</video:tag>
+      <video:tag>vimeo</video:tag>
+      <video:uploader info="https://vimeo.com/staff">Staff</video:uploader>
+    </video:video>
+  </url>
+</urlset>
+`
+	if got := buf.String(); got != want {
+		t.Errorf("VideoSitemap.WriteFile returned\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestVideoSitemap_split(t *testing.T) {
+	s := &VideoSitemap{MaxURLs: 2}
+	for i := 1; i <= 5; i++ {
+		s.Add(sitemapTestVideo(i))
+	}
+
+	if s.Len() != 3 {
+		t.Fatalf("VideoSitemap.Len returned %v, want %v", s.Len(), 3)
+	}
+
+	var buf bytes.Buffer
+	err := s.WriteIndex(&buf, func(i int) string {
+		return fmt.Sprintf("https://example.com/sitemap-%d.xml", i)
+	})
+	if err != nil {
+		t.Fatalf("VideoSitemap.WriteIndex returned unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"<loc>https://example.com/sitemap-2.xml</loc>",
+		"<lastmod>2021-01-01T00:00:04Z</lastmod>",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("VideoSitemap.WriteIndex returned %v, want it to contain %v", buf.String(), want)
+		}
+	}
+
+	if err := s.WriteFile(3, &buf); err == nil {
+		t.Error("VideoSitemap.WriteFile expected error for a missing file")
+	}
+}
+
+func TestNewVideoObject(t *testing.T) {
+	got, err := NewVideoObject(sitemapTestVideo(1)).JSONLD()
+	if err != nil {
+		t.Fatalf("VideoObject.JSONLD returned unexpected error: %v", err)
+	}
+
+	want := `<script type="application/ld+json">{"@context":"https://schema.org","@type":"VideoObject","name":"Video \u003c1\u003e","description":"Video \u003c1\u003e","thumbnailUrl":["https://i.vimeocdn.com/small.jpg","https://i.vimeocdn.com/large.jpg"],"uploadDate":"2020-01-02T03:04:05Z","duration":"PT1H2M3S","embedUrl":"https://player.vimeo.com/video/1","url":"https://vimeo.com/1","keywords":"go,vimeo","isFamilyFriendly":true,"author":{"@type":"Person","name":"Staff","url":"https://vimeo.com/staff"},"interactionStatistic":{"@type":"InteractionCounter","interactionType":"https://schema.org/WatchAction","userInteractionCount":42}}</script>`
+	if got != want {
+		t.Errorf("VideoObject.JSONLD returned\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestISODuration(t *testing.T) {
+	for in, want := range map[int]string{0: "PT0S", 59: "PT59S", 60: "PT1M", 3600: "PT1H", 3723: "PT1H2M3S"} {
+		if got := isoDuration(in); got != want {
+			t.Errorf("isoDuration(%v) returned %v, want %v", in, got, want)
+		}
+	}
+}
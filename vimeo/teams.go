@@ -0,0 +1,147 @@
+package vimeo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TeamsService handles communication with the team related
+// methods of the Vimeo API.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams
+type TeamsService service
+
+// TeamRole is the permission level of a team member, on the team or on a folder.
+type TeamRole string
+
+// The roles of team members.
+const (
+	TeamRoleOwner       TeamRole = "owner"
+	TeamRoleAdmin       TeamRole = "admin"
+	TeamRoleContributor TeamRole = "contributor"
+	TeamRoleUploader    TeamRole = "uploader"
+	TeamRoleViewer      TeamRole = "viewer"
+)
+
+// The statuses of team members.
+const (
+	TeamMemberActive  = "active"
+	TeamMemberPending = "pending"
+)
+
+// TeamMember represents a member of a team, or a pending invite.
+type TeamMember struct {
+	URI          string    `json:"uri,omitempty"`
+	Email        string    `json:"email,omitempty"`
+	Name         string    `json:"name,omitempty"`
+	Role         TeamRole  `json:"permission_level,omitempty"`
+	Status       string    `json:"status,omitempty"`
+	InviteURL    string    `json:"invite_url,omitempty"`
+	CreatedTime  time.Time `json:"created_time,omitempty"`
+	ModifiedTime time.Time `json:"modified_time,omitempty"`
+	User         *User     `json:"user,omitempty"`
+	Metadata     *Metadata `json:"metadata,omitempty"`
+}
+
+// TeamInviteRequest represents a request to invite a person to a team.
+type TeamInviteRequest struct {
+	Email         string   `json:"email"`
+	Role          TeamRole `json:"permission_level"`
+	FolderURI     string   `json:"folder_uri,omitempty"`
+	CustomMessage string   `json:"custom_message,omitempty"`
+	Locale        string   `json:"locale,omitempty"`
+}
+
+type teamRoleRequest struct {
+	Role TeamRole `json:"permission_level"`
+}
+
+// GetID returns the identifier (ID) of the team member.
+func (m TeamMember) GetID() string {
+	l := strings.SplitN(m.URI, "/", -1)
+	return l[len(l)-1]
+}
+
+// IsPending reports whether the member hasn't accepted the invite yet.
+func (m TeamMember) IsPending() bool {
+	return m.Status == TeamMemberPending
+}
+
+func teamMembersPath(uid string) string {
+	if uid == "" {
+		return "me/team_members"
+	}
+	return fmt.Sprintf("users/%s/team_members", uid)
+}
+
+// ListMember method returns the members of the user's team, including pending invites.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#get_team_members
+func (s *TeamsService) ListMember(uid string, opt ...CallOption) ([]*TeamMember, *Response, error) {
+	return List[TeamMember](s.client, teamMembersPath(uid), opt...)
+}
+
+// ListInvite method returns the pending invites of the user's team.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#get_team_members
+func (s *TeamsService) ListInvite(uid string, opt ...CallOption) ([]*TeamMember, *Response, error) {
+	opt = append(opt, OptFilter(TeamMemberPending))
+	return List[TeamMember](s.client, teamMembersPath(uid), opt...)
+}
+
+// GetMember method returns a single member of the user's team.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#get_team_member
+func (s *TeamsService) GetMember(uid string, mid string, opt ...CallOption) (*TeamMember, *Response, error) {
+	return Get[TeamMember](s.client, fmt.Sprintf("%s/%s", teamMembersPath(uid), mid), opt...)
+}
+
+// Invite method invites a person to the user's team with the given role.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#add_team_member
+func (s *TeamsService) Invite(uid string, r *TeamInviteRequest) (*TeamMember, *Response, error) {
+	return Post[TeamMember](s.client, teamMembersPath(uid), r)
+}
+
+// SetRole method changes the role of a member of the user's team.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#edit_team_member
+func (s *TeamsService) SetRole(uid string, mid string, role TeamRole) (*TeamMember, *Response, error) {
+	return Patch[TeamMember](s.client, fmt.Sprintf("%s/%s", teamMembersPath(uid), mid), &teamRoleRequest{Role: role})
+}
+
+// RemoveMember method removes a member from the user's team, or cancels a pending invite.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#delete_team_member
+func (s *TeamsService) RemoveMember(uid string, mid string) (*Response, error) {
+	return Delete(s.client, fmt.Sprintf("%s/%s", teamMembersPath(uid), mid))
+}
+
+// ListFolderMember method returns the team members who were granted access to the folder.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#get_folder_team_members
+func (s *TeamsService) ListFolderMember(folderURI string, opt ...CallOption) ([]*TeamMember, *Response, error) {
+	return List[TeamMember](s.client, strings.TrimPrefix(folderURI, "/")+"/team_members", opt...)
+}
+
+// GrantFolder method grants a team member the given role on the folder.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#add_folder_team_member
+func (s *TeamsService) GrantFolder(folderURI string, mid string, role TeamRole) (*Response, error) {
+	u := fmt.Sprintf("%s/team_members/%s", strings.TrimPrefix(folderURI, "/"), mid)
+	return Put(s.client, u, &teamRoleRequest{Role: role})
+}
+
+// RevokeFolder method removes the access of a team member to the folder.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/teams#delete_folder_team_member
+func (s *TeamsService) RevokeFolder(folderURI string, mid string) (*Response, error) {
+	return Delete(s.client, fmt.Sprintf("%s/team_members/%s", strings.TrimPrefix(folderURI, "/"), mid))
+}
@@ -0,0 +1,155 @@
+package vimeo
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTeamMember_GetID(t *testing.T) {
+	m := &TeamMember{URI: "/users/1/team_members/abc"}
+
+	if id := m.GetID(); id != "abc" {
+		t.Errorf("TeamMember.GetID returned %+v, want %+v", id, "abc")
+	}
+}
+
+func TestTeamsService_ListMember(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/team_members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"email": "a@example.com", "permission_level": "admin", "status": "active"}]}`)
+	})
+
+	members, _, err := client.Teams.ListMember("", OptPage(1), OptPerPage(2))
+	if err != nil {
+		t.Errorf("Teams.ListMember returned unexpected error: %v", err)
+	}
+
+	want := []*TeamMember{{Email: "a@example.com", Role: TeamRoleAdmin, Status: TeamMemberActive}}
+	if !reflect.DeepEqual(members, want) {
+		t.Errorf("Teams.ListMember returned %+v, want %+v", members, want)
+	}
+}
+
+func TestTeamsService_ListInvite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/team_members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"filter": "pending",
+		})
+		fmt.Fprint(w, `{"data": [{"email": "a@example.com", "status": "pending"}]}`)
+	})
+
+	invites, _, err := client.Teams.ListInvite("1")
+	if err != nil {
+		t.Errorf("Teams.ListInvite returned unexpected error: %v", err)
+	}
+
+	if len(invites) != 1 || !invites[0].IsPending() {
+		t.Errorf("Teams.ListInvite returned %+v", invites)
+	}
+}
+
+func TestTeamsService_Invite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/team_members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"email":"a@example.com","permission_level":"contributor","folder_uri":"/users/1/projects/2"}`+"\n")
+		fmt.Fprint(w, `{"email": "a@example.com", "permission_level": "contributor", "status": "pending"}`)
+	})
+
+	member, _, err := client.Teams.Invite("", &TeamInviteRequest{
+		Email:     "a@example.com",
+		Role:      TeamRoleContributor,
+		FolderURI: "/users/1/projects/2",
+	})
+	if err != nil {
+		t.Errorf("Teams.Invite returned unexpected error: %v", err)
+	}
+
+	want := &TeamMember{Email: "a@example.com", Role: TeamRoleContributor, Status: TeamMemberPending}
+	if !reflect.DeepEqual(member, want) {
+		t.Errorf("Teams.Invite returned %+v, want %+v", member, want)
+	}
+}
+
+func TestTeamsService_SetRole(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/team_members/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"permission_level":"viewer"}`+"\n")
+		fmt.Fprint(w, `{"permission_level": "viewer"}`)
+	})
+
+	member, _, err := client.Teams.SetRole("", "abc", TeamRoleViewer)
+	if err != nil {
+		t.Errorf("Teams.SetRole returned unexpected error: %v", err)
+	}
+
+	if member.Role != TeamRoleViewer {
+		t.Errorf("Teams.SetRole returned %+v", member)
+	}
+}
+
+func TestTeamsService_RemoveMember(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/team_members/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Teams.RemoveMember("", "abc")
+	if err != nil {
+		t.Errorf("Teams.RemoveMember returned unexpected error: %v", err)
+	}
+}
+
+func TestTeamsService_FolderPermissions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/projects/2/team_members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"email": "a@example.com", "permission_level": "viewer"}]}`)
+	})
+	mux.HandleFunc("/users/1/projects/2/team_members/abc", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			testBody(t, r, `{"permission_level":"contributor"}`+"\n")
+		case "DELETE":
+		default:
+			t.Errorf("Request method: %v, want PUT or DELETE", r.Method)
+		}
+	})
+
+	members, _, err := client.Teams.ListFolderMember("/users/1/projects/2")
+	if err != nil {
+		t.Errorf("Teams.ListFolderMember returned unexpected error: %v", err)
+	}
+	if want := []*TeamMember{{Email: "a@example.com", Role: TeamRoleViewer}}; !reflect.DeepEqual(members, want) {
+		t.Errorf("Teams.ListFolderMember returned %+v, want %+v", members, want)
+	}
+
+	if _, err := client.Teams.GrantFolder("/users/1/projects/2", "abc", TeamRoleContributor); err != nil {
+		t.Errorf("Teams.GrantFolder returned unexpected error: %v", err)
+	}
+	if _, err := client.Teams.RevokeFolder("/users/1/projects/2", "abc"); err != nil {
+		t.Errorf("Teams.RevokeFolder returned unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+//go:build ignore
+
+// Capture fetches a video from the API and writes it as a test fixture with
+// the secrets scrubbed: resource keys, file checksums, the unlisted hashes
+// and the signatures of the file links.
+//
+//	VIMEO_TOKEN=... go run testdata/capture.go -video 76979871 > testdata/video.json
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+)
+
+const scrubbed = "scrubbed"
+
+// scrubKeys are the fields whose values are replaced.
+var scrubKeys = map[string]bool{
+	"resource_key": true,
+	"md5":          true,
+}
+
+// scrubValues are the secrets found inside links.
+var scrubValues = []struct {
+	re   *regexp.Regexp
+	repl string
+}{
+	{regexp.MustCompile(`(videos/\d+):[0-9a-f]+`), "${1}:" + scrubbed},
+	{regexp.MustCompile(`([?&](?:s|h|signature|token)=)[^&]+`), "${1}" + scrubbed},
+	{regexp.MustCompile(`(/review/\d+/)[0-9a-f]+`), "${1}" + scrubbed},
+	{regexp.MustCompile(`(i\.vimeocdn\.com/(?:video|portrait)/\d+-)[0-9a-f]+`), "${1}" + scrubbed},
+}
+
+func main() {
+	video := flag.Int("video", 76979871, "ID of the video to capture")
+	flag.Parse()
+
+	token := os.Getenv("VIMEO_TOKEN")
+	if token == "" {
+		log.Fatal("VIMEO_TOKEN is not set")
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("https://api.vimeo.com/videos/%d", *video), nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	req.Header.Set("Accept", "application/vnd.vimeo.*+json;version=3.4")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("GET %s: %s: %s", req.URL, resp.Status, body)
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		log.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(scrub("", v)); err != nil {
+		log.Fatal(err)
+	}
+
+	os.Stdout.Write(out.Bytes())
+}
+
+func scrub(key string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = scrub(k, e)
+		}
+		return v
+	case []interface{}:
+		for i, e := range v {
+			v[i] = scrub(key, e)
+		}
+		return v
+	case string:
+		if scrubKeys[key] {
+			return scrubbed
+		}
+		for _, s := range scrubValues {
+			v = s.re.ReplaceAllString(v, s.repl)
+		}
+		return v
+	}
+	return v
+}
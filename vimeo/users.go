@@ -12,11 +12,6 @@ import (
 // Vimeo API docs: https://developer.vimeo.com/api/reference/users
 type UsersService service
 
-type dataListUser struct {
-	Data []*User `json:"data"`
-	pagination
-}
-
 // WebSite represents a web site.
 type WebSite struct {
 	Name        string `json:"name,omitempty"`
@@ -36,37 +31,20 @@ type User struct {
 	Pictures      *Pictures  `json:"pictures,omitempty"`
 	WebSites      []*WebSite `json:"websites,omitempty"`
 	ContentFilter []string   `json:"content_filter,omitempty"`
+	Metadata      *Metadata  `json:"metadata,omitempty"`
 	ResourceKey   string     `json:"resource_key,omitempty"`
 }
 
 // UserRequest represents a request to create/edit an user.
+// Nil fields are left out of the request.
 type UserRequest struct {
-	Name     string `json:"name,omitempty"`
-	Location string `json:"location,omitempty"`
-	Bio      string `json:"bio,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Location *string `json:"location,omitempty"`
+	Bio      *string `json:"bio,omitempty"`
 }
 
 func listUser(c *Client, url string, opt ...CallOption) ([]*User, *Response, error) {
-	u, err := addOptions(url, opt...)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	req, err := c.NewRequest("GET", u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	users := &dataListUser{}
-
-	resp, err := c.Do(req, users)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	resp.setPaging(users)
-
-	return users.Data, resp, err
+	return List[User](c, url, opt...)
 }
 
 // Search method information about this method appears below.
@@ -2,6 +2,8 @@ package vimeo
 
 import (
 	"fmt"
+	"os"
+	"strings"
 	"time"
 )
 
@@ -10,40 +12,132 @@ type dataListAlbum struct {
 	pagination
 }
 
-// Privacy internal object provides access to privacy.
-type Privacy struct {
-	View     string `json:"view,omitempty"`
-	Join     string `json:"join,omitempty"`
-	Videos   string `json:"videos,omitempty"`
-	Comment  string `json:"comment,omitempty"`
-	Forums   string `json:"forums,omitempty"`
-	Invite   string `json:"invite,omitempty"`
-	Embed    string `json:"embed,omitempty"`
-	Download bool   `json:"download"`
-	Add      bool   `json:"add"`
-}
-
 // Album represents a album.
 type Album struct {
-	URI          string    `json:"uri,omitempty"`
-	Name         string    `json:"name,omitempty"`
-	Description  string    `json:"description,omitempty"`
-	Link         string    `json:"link,omitempty"`
-	Duration     int       `json:"duration,omitempty"`
-	CreatedTime  time.Time `json:"created_time,omitempty"`
-	ModifiedTime time.Time `json:"modified_time,omitempty"`
-	User         *User     `json:"user,omitempty"`
-	Pictures     *Pictures `json:"pictures,omitempty"`
-	Privacy      *Privacy  `json:"privacy,omitempty"`
+	URI                    string        `json:"uri,omitempty"`
+	Name                   string        `json:"name,omitempty"`
+	Description            string        `json:"description,omitempty"`
+	Link                   string        `json:"link,omitempty"`
+	Duration               int           `json:"duration,omitempty"`
+	CreatedTime            time.Time     `json:"created_time,omitempty"`
+	ModifiedTime           time.Time     `json:"modified_time,omitempty"`
+	User                   *User         `json:"user,omitempty"`
+	Pictures               *Pictures     `json:"pictures,omitempty"`
+	Privacy                *AlbumPrivacy `json:"privacy,omitempty"`
+	Metadata               *Metadata     `json:"metadata,omitempty"`
+	Sort                   AlbumSort     `json:"sort,omitempty"`
+	Layout                 string        `json:"layout,omitempty"`
+	Theme                  string        `json:"theme,omitempty"`
+	BrandColor             string        `json:"brand_color,omitempty"`
+	HideNav                bool          `json:"hide_nav"`
+	HideUpcoming           bool          `json:"hide_upcoming"`
+	HideVimeoLogo          bool          `json:"hide_vimeo_logo"`
+	ReviewMode             bool          `json:"review_mode"`
+	Autoplay               bool          `json:"autoplay"`
+	Loop                   bool          `json:"loop"`
+	AllowDownloads         bool          `json:"allow_downloads"`
+	AllowShare             bool          `json:"allow_share"`
+	AllowContinuousPlay    bool          `json:"allow_continuous_play"`
+	EmbedBrandColor        bool          `json:"embed_brand_color"`
+	EmbedCustomLogo        bool          `json:"embed_custom_logo"`
+	WebBrandColor          bool          `json:"web_brand_color"`
+	WebCustomLogo          bool          `json:"web_custom_logo"`
+	CustomLogo             *Pictures     `json:"custom_logo,omitempty"`
+	Embed                  *AlbumEmbed   `json:"embed,omitempty"`
+	URL                    string        `json:"url,omitempty"`
+	Domain                 string        `json:"domain,omitempty"`
+	DomainCertificateState string        `json:"domain_certificate_state,omitempty"`
+	UseCustomDomain        bool          `json:"use_custom_domain"`
+	SEOTitle               string        `json:"seo_title,omitempty"`
+	SEODescription         string        `json:"seo_description,omitempty"`
+	SEOKeywords            []string      `json:"seo_keywords,omitempty"`
+	SEOAllowIndexed        bool          `json:"seo_allow_indexed"`
+	HasChosenThumbnail     bool          `json:"has_chosen_thumbnail"`
+	ShareLink              string        `json:"share_link,omitempty"`
+	ResourceKey            string        `json:"resource_key,omitempty"`
+}
+
+// AlbumEmbed internal object provides access to the embed code of an album.
+type AlbumEmbed struct {
+	HTML string `json:"html,omitempty"`
 }
 
 // AlbumRequest represents a request to create/edit an album.
+// Nil fields are left out of the request.
 type AlbumRequest struct {
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
-	Privacy     string `json:"privacy,omitempty"`
-	Password    string `json:"password,omitempty"`
-	Sort        string `json:"sort,omitempty"`
+	Name                *string    `json:"name,omitempty"`
+	Description         *string    `json:"description,omitempty"`
+	Privacy             *AlbumView `json:"privacy,omitempty"`
+	Password            *string    `json:"password,omitempty"`
+	Sort                *AlbumSort `json:"sort,omitempty"`
+	Layout              *string    `json:"layout,omitempty"`
+	Theme               *string    `json:"theme,omitempty"`
+	BrandColor          *string    `json:"brand_color,omitempty"`
+	HideNav             *bool      `json:"hide_nav,omitempty"`
+	HideUpcoming        *bool      `json:"hide_upcoming,omitempty"`
+	HideVimeoLogo       *bool      `json:"hide_vimeo_logo,omitempty"`
+	ReviewMode          *bool      `json:"review_mode,omitempty"`
+	Autoplay            *bool      `json:"autoplay,omitempty"`
+	Loop                *bool      `json:"loop,omitempty"`
+	AllowDownloads      *bool      `json:"allow_downloads,omitempty"`
+	AllowShare          *bool      `json:"allow_share,omitempty"`
+	AllowContinuousPlay *bool      `json:"allow_continuous_play,omitempty"`
+	EmbedBrandColor     *bool      `json:"embed_brand_color,omitempty"`
+	EmbedCustomLogo     *bool      `json:"embed_custom_logo,omitempty"`
+	WebBrandColor       *bool      `json:"web_brand_color,omitempty"`
+	WebCustomLogo       *bool      `json:"web_custom_logo,omitempty"`
+	URL                 *string    `json:"url,omitempty"`
+	Domain              *string    `json:"domain,omitempty"`
+	UseCustomDomain     *bool      `json:"use_custom_domain,omitempty"`
+	SEOTitle            *string    `json:"seo_title,omitempty"`
+	SEODescription      *string    `json:"seo_description,omitempty"`
+	SEOKeywords         []string   `json:"seo_keywords,omitempty"`
+	SEOAllowIndexed     *bool      `json:"seo_allow_indexed,omitempty"`
+}
+
+// AlbumLogoRequest represents a request to edit a custom logo of an album.
+// Nil fields are left out of the request.
+type AlbumLogoRequest struct {
+	Active *bool   `json:"active,omitempty"`
+	Link   *string `json:"link,omitempty"`
+	Sticky *bool   `json:"sticky,omitempty"`
+}
+
+// userURI is an element of the request body that grants users access to a resource.
+type userURI struct {
+	URI string `json:"uri"`
+}
+
+// userURIs returns the request body that grants access to the users, given as IDs, URIs or links.
+func userURIs(users []string) ([]*userURI, error) {
+	body := make([]*userURI, len(users))
+	for i, u := range users {
+		id, err := userID(u)
+		if err != nil {
+			return nil, err
+		}
+		body[i] = &userURI{URI: "/users/" + id}
+	}
+	return body, nil
+}
+
+// userID returns the numeric ID or the custom URL of a user given as an ID, a URI or a link.
+func userID(u string) (string, error) {
+	u = strings.TrimSpace(u)
+	if reNumeric.MatchString(u) {
+		return u, nil
+	}
+	if ref, err := ParseRef(u); err == nil && ref.Kind == RefUser {
+		return ref.ID, nil
+	}
+	return "", fmt.Errorf("not a user reference: %q", u)
+}
+
+func albumPath(uid string, ab string) string {
+	if uid == "" {
+		return fmt.Sprintf("me/albums/%s", ab)
+	}
+	return fmt.Sprintf("users/%s/albums/%s", uid, ab)
 }
 
 // ListAlbum method gets all the albums from the specified user's account.
@@ -85,6 +179,10 @@ func (s *UsersService) ListAlbum(uid string, opt ...CallOption) ([]*Album, *Resp
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/albums#create_album
 func (s *UsersService) CreateAlbum(uid string, r *AlbumRequest) (*Album, *Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, nil, err
+	}
+
 	var u string
 	if uid == "" {
 		u = "me/albums"
@@ -143,6 +241,10 @@ func (s *UsersService) GetAlbum(uid string, ab string, opt ...CallOption) (*Albu
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/albums#edit_album
 func (s *UsersService) EditAlbum(uid string, ab string, r *AlbumRequest) (*Album, *Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, nil, err
+	}
+
 	var u string
 	if uid == "" {
 		u = fmt.Sprintf("me/albums/%s", ab)
@@ -248,3 +350,242 @@ func (s *UsersService) AlbumDeleteVideo(uid string, ab string, vid int) (*Respon
 
 	return resp, err
 }
+
+// AlbumSetThumbnail method sets a frame of a video in the album as the album thumbnail.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#set_video_as_album_thumbnail
+func (s *UsersService) AlbumSetThumbnail(uid string, ab string, vid int, timeCode float32) (*Album, *Response, error) {
+	u := fmt.Sprintf("%s/videos/%d/set_album_thumbnail", albumPath(uid, ab), vid)
+
+	body := struct {
+		TimeCode float32 `json:"time_code"`
+	}{timeCode}
+
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	album := &Album{}
+	resp, err := s.client.Do(req, album)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return album, resp, nil
+}
+
+// AlbumSetFeaturedVideo method sets a video in the album as the featured video of the album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#set_video_as_album_featured_video
+func (s *UsersService) AlbumSetFeaturedVideo(uid string, ab string, vid int) (*Album, *Response, error) {
+	u := fmt.Sprintf("%s/videos/%d/set_featured_video", albumPath(uid, ab), vid)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	album := &Album{}
+	resp, err := s.client.Do(req, album)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return album, resp, nil
+}
+
+// AlbumReplaceVideos method replaces all the videos in the album, in the given order.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#replace_videos_in_album
+func (s *UsersService) AlbumReplaceVideos(uid string, ab string, vids []int) (*Response, error) {
+	uris := make([]string, len(vids))
+	for i, vid := range vids {
+		uris[i] = fmt.Sprintf("/videos/%d", vid)
+	}
+
+	body := struct {
+		Videos string `json:"videos"`
+	}{strings.Join(uris, ",")}
+
+	req, err := s.client.NewRequest("PUT", albumPath(uid, ab)+"/videos", body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// AlbumReorderVideos method orders the videos of the album by their position in vids.
+// The album videos left out of vids keep their relative order after the given ones.
+// It switches the album to the "arranged" sort, then replaces the videos in that order.
+// Passing the empty string will edit authenticated user.
+func (s *UsersService) AlbumReorderVideos(uid string, ab string, vids []int) (*Response, error) {
+	current, err := NewIterator(func(opt ...CallOption) ([]*Video, *Response, error) {
+		return s.AlbumListVideo(uid, ab, opt...)
+	}, OptFields{"uri"}, OptPerPage(maxBatchSize)).All()
+	if err != nil {
+		return nil, err
+	}
+
+	order := append([]int(nil), vids...)
+	given := make(map[int]bool, len(vids))
+	for _, vid := range vids {
+		given[vid] = true
+	}
+	for _, v := range current {
+		if id := v.GetID(); id != 0 && !given[id] {
+			order = append(order, id)
+		}
+	}
+
+	_, resp, err := s.EditAlbum(uid, ab, (&AlbumRequest{}).SetSort(AlbumSortArranged))
+	if err != nil {
+		return resp, err
+	}
+
+	return s.AlbumReplaceVideos(uid, ab, order)
+}
+
+// AlbumListLogo method returns all the custom logos of the album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#get_album_logos
+func (s *UsersService) AlbumListLogo(uid string, ab string, opt ...CallOption) ([]*Pictures, *Response, error) {
+	return List[Pictures](s.client, albumPath(uid, ab)+"/logos", opt...)
+}
+
+// AlbumCreateLogo method adds a custom logo to the album. The returned Link is the upload link of the image.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#create_album_logo
+func (s *UsersService) AlbumCreateLogo(uid string, ab string) (*Pictures, *Response, error) {
+	return Post[Pictures](s.client, albumPath(uid, ab)+"/logos", nil)
+}
+
+// AlbumGetLogo method returns a single custom logo of the album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#get_album_logo
+func (s *UsersService) AlbumGetLogo(uid string, ab string, lid int, opt ...CallOption) (*Pictures, *Response, error) {
+	return Get[Pictures](s.client, fmt.Sprintf("%s/logos/%d", albumPath(uid, ab), lid), opt...)
+}
+
+// AlbumEditLogo method edits a custom logo of the album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#replace_album_logo
+func (s *UsersService) AlbumEditLogo(uid string, ab string, lid int, r *AlbumLogoRequest) (*Pictures, *Response, error) {
+	return Patch[Pictures](s.client, fmt.Sprintf("%s/logos/%d", albumPath(uid, ab), lid), r)
+}
+
+// AlbumDeleteLogo method deletes a custom logo from the album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#delete_album_logo
+func (s *UsersService) AlbumDeleteLogo(uid string, ab string, lid int) (*Response, error) {
+	return Delete(s.client, fmt.Sprintf("%s/logos/%d", albumPath(uid, ab), lid))
+}
+
+// AlbumUploadLogo shortcut upload a custom logo file and make it the active logo of the album.
+// Passing the empty string will edit authenticated user.
+func (s *UsersService) AlbumUploadLogo(uid string, ab string, file *os.File) (*Pictures, *Response, error) {
+	logo, err := uploadPicture(s.client, file, func() (*Pictures, error) {
+		p, _, err := s.AlbumCreateLogo(uid, ab)
+		return p, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.AlbumEditLogo(uid, ab, logo.GetID(), &AlbumLogoRequest{Active: Bool(true)})
+}
+
+// AlbumListUser method returns all the users who can view the specified album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#get_album_privacy_users
+func (s *UsersService) AlbumListUser(uid string, ab string, opt ...CallOption) ([]*User, *Response, error) {
+	users, resp, err := listUser(s.client, albumPath(uid, ab)+"/privacy/users", opt...)
+
+	return users, resp, err
+}
+
+// AlbumAllowUsers method gives multiple users permission to view the specified album.
+// The users are IDs, URIs or links.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#add_album_privacy_users
+func (s *UsersService) AlbumAllowUsers(uid string, ab string, uids []string) ([]*User, *Response, error) {
+	body, err := userURIs(uids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("PUT", albumPath(uid, ab)+"/privacy/users", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*User
+	resp, err := s.client.Do(req, &users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
+
+// AlbumAllowUser method gives a single user permission to view the specified album.
+// The user is an ID, a URI or a link.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#add_album_privacy_user
+func (s *UsersService) AlbumAllowUser(uid string, ab string, user string) (*Response, error) {
+	id, err := userID(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return Put(s.client, fmt.Sprintf("%s/privacy/users/%s", albumPath(uid, ab), id), nil)
+}
+
+// AlbumDisallowUser method prevents a user from being able to view the specified album.
+// The user is an ID, a URI or a link.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#delete_album_privacy_user
+func (s *UsersService) AlbumDisallowUser(uid string, ab string, user string) (*Response, error) {
+	id, err := userID(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return Delete(s.client, fmt.Sprintf("%s/privacy/users/%s", albumPath(uid, ab), id))
+}
+
+// AlbumListDomain method returns all the domains on which the specified album can be embedded.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#get_album_privacy_domains
+func (s *UsersService) AlbumListDomain(uid string, ab string, opt ...CallOption) ([]*Domain, *Response, error) {
+	return List[Domain](s.client, albumPath(uid, ab)+"/privacy/domains", opt...)
+}
+
+// AlbumAllowDomain method adds the specified domain to an album's embed allowlist.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#add_album_privacy_domain
+func (s *UsersService) AlbumAllowDomain(uid string, ab string, d string) (*Response, error) {
+	return Put(s.client, fmt.Sprintf("%s/privacy/domains/%s", albumPath(uid, ab), d), nil)
+}
+
+// AlbumDisallowDomain method removes the specified domain from an album's embed allowlist.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/albums#delete_album_privacy_domain
+func (s *UsersService) AlbumDisallowDomain(uid string, ab string, d string) (*Response, error) {
+	return Delete(s.client, fmt.Sprintf("%s/privacy/domains/%s", albumPath(uid, ab), d))
+}
@@ -19,6 +19,15 @@ type Folder struct {
 	CreatedTime  time.Time `json:"created_time,omitempty"`
 	ModifiedTime time.Time `json:"modified_time,omitempty"`
 	ParentFolder *Folder   `json:"parent_folder,omitempty"`
+	Metadata     *Metadata `json:"metadata,omitempty"`
+}
+
+// FolderRequest represents a request to create/edit a folder.
+// Nil fields are left out of the request.
+type FolderRequest struct {
+	Name *string `json:"name,omitempty"`
+	// ParentFolderURI is the URI of the folder to create or move the folder into.
+	ParentFolderURI *string `json:"parent_folder_uri,omitempty"`
 }
 
 func listFolder(c *Client, url string, opt ...CallOption) ([]*Folder, *Response, error) {
@@ -113,3 +122,87 @@ func (s *UsersService) ListFolderVideos(folderURI string, opt ...CallOption) ([]
 	u := strings.TrimPrefix(folderURI, "/") + "/videos"
 	return listVideo(s.client, u, opt...)
 }
+
+// CreateFolder creates a new folder for a user, under a parent folder when r.ParentFolderURI is set.
+// Passing the empty string will use the authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#create_project
+func (s *UsersService) CreateFolder(uid string, r *FolderRequest) (*Folder, *Response, error) {
+	var u string
+	if uid == "" {
+		u = "me/folders"
+	} else {
+		u = fmt.Sprintf("users/%s/folders", uid)
+	}
+	return Post[Folder](s.client, u, r)
+}
+
+// EditFolder edits a folder. Setting r.ParentFolderURI moves the folder under another parent.
+// folderURI is the full URI returned by the API (e.g. "/users/12345/projects/67890").
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#edit_project
+func (s *UsersService) EditFolder(folderURI string, r *FolderRequest) (*Folder, *Response, error) {
+	return Patch[Folder](s.client, strings.TrimPrefix(folderURI, "/"), r)
+}
+
+// MoveFolder moves a folder under the parent folder.
+// Both URIs are the full URIs returned by the API (e.g. "/users/12345/projects/67890").
+func (s *UsersService) MoveFolder(folderURI string, parentURI string) (*Folder, *Response, error) {
+	return s.EditFolder(folderURI, &FolderRequest{ParentFolderURI: &parentURI})
+}
+
+// DeleteFolder deletes a folder. With deleteVideos the videos inside the folder are deleted too,
+// otherwise they are moved out of it.
+// folderURI is the full URI returned by the API (e.g. "/users/12345/projects/67890").
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#delete_project
+func (s *UsersService) DeleteFolder(folderURI string, deleteVideos bool) (*Response, error) {
+	u := strings.TrimPrefix(folderURI, "/")
+	if deleteVideos {
+		u += "?should_delete_clips=true"
+	}
+	return Delete(s.client, u)
+}
+
+// AddVideoToFolder adds a video to a folder.
+// folderURI is the full URI returned by the API (e.g. "/users/12345/projects/67890").
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#add_video_to_project
+func (s *UsersService) AddVideoToFolder(folderURI string, vid int) (*Response, error) {
+	return Put(s.client, fmt.Sprintf("%s/videos/%d", strings.TrimPrefix(folderURI, "/"), vid), nil)
+}
+
+// RemoveVideoFromFolder removes a video from a folder, the video itself isn't deleted.
+// folderURI is the full URI returned by the API (e.g. "/users/12345/projects/67890").
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#remove_video_from_project
+func (s *UsersService) RemoveVideoFromFolder(folderURI string, vid int) (*Response, error) {
+	return Delete(s.client, fmt.Sprintf("%s/videos/%d", strings.TrimPrefix(folderURI, "/"), vid))
+}
+
+// MoveVideosToFolder moves many videos into a folder, in batches of up to 100 videos per request.
+// A video belongs to a single folder, so the videos leave their current folders.
+// folderURI is the full URI returned by the API (e.g. "/users/12345/projects/67890").
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders#add_videos_to_project
+func (s *UsersService) MoveVideosToFolder(folderURI string, vids []int) (*Response, error) {
+	var resp *Response
+	for start := 0; start < len(vids); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(vids) {
+			end = len(vids)
+		}
+
+		uris := make(OptURIs, 0, end-start)
+		for _, vid := range vids[start:end] {
+			uris = append(uris, fmt.Sprintf("/videos/%d", vid))
+		}
+
+		var err error
+		resp, err = Put(s.client, strings.TrimPrefix(folderURI, "/")+"/videos", nil, uris)
+		if err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
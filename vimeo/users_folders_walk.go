@@ -0,0 +1,260 @@
+package vimeo
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SkipFolder is used as a return value from a FolderWalkFunc to indicate that
+// the folder named in the call is to be skipped. It is not returned as an error by any function.
+var SkipFolder = errors.New("skip this folder")
+
+var errWalkStopped = errors.New("folder walk stopped")
+
+// FolderWalkFunc is the type of the function called by WalkFolders for each item of the tree.
+// The parents are the folders above the item, from the root down. Returning SkipFolder
+// for a folder item skips its subtree, any other error stops the walk.
+type FolderWalkFunc func(item *FolderItem, parents []*Folder) error
+
+// WalkOrder is the order in which WalkFolders visits the tree.
+type WalkOrder int
+
+// The orders in which WalkFolders visits the tree.
+const (
+	DepthFirst WalkOrder = iota
+	BreadthFirst
+)
+
+// WalkOptions configures WalkFolders.
+type WalkOptions struct {
+	Order WalkOrder
+	// Root is the URI of the folder to walk (e.g. "/users/12345/projects/67890").
+	// The empty string walks every root-level folder of the user.
+	Root string
+	// Concurrency limits the number of folders fetched at the same time, Config.Concurrency when zero.
+	Concurrency int
+}
+
+type folderFetch struct {
+	done  chan struct{}
+	items []*FolderItem
+	err   error
+}
+
+type pendingFolder struct {
+	fetch   *folderFetch
+	parents []*Folder
+}
+
+type folderWalker struct {
+	s    *UsersService
+	fn   FolderWalkFunc
+	sem  chan struct{}
+	seen map[string]bool
+	// stop is closed when the walk returns, so fetches that haven't started yet are dropped.
+	stop chan struct{}
+}
+
+// WalkFolders walks the folder hierarchy of a user, calling fn for each folder and video.
+// Every page of every folder is followed, and a folder is fetched only once fn has been called for it
+// without returning SkipFolder. Breadth-first, the folders of the next level are fetched in the background
+// while fn runs, up to opts.Concurrency at a time; fn itself is never called concurrently.
+// A folder met a second time, for example because of a cycle, is neither visited nor descended again.
+// Passing the empty string will use the authenticated user.
+func (s *UsersService) WalkFolders(uid string, opts *WalkOptions, fn FolderWalkFunc) error {
+	if opts == nil {
+		opts = &WalkOptions{}
+	}
+
+	n := opts.Concurrency
+	if n < 1 {
+		n = s.client.Config.concurrency()
+	}
+
+	w := &folderWalker{s: s, fn: fn, sem: make(chan struct{}, n), seen: make(map[string]bool), stop: make(chan struct{})}
+	defer close(w.stop)
+
+	var items []*FolderItem
+	if opts.Root == "" {
+		folders, err := NewIterator(func(opt ...CallOption) ([]*Folder, *Response, error) {
+			return s.ListFolders(uid, opt...)
+		}).All()
+		if err != nil {
+			return err
+		}
+		for _, f := range folders {
+			items = append(items, &FolderItem{Type: "folder", Folder: f})
+		}
+	} else {
+		w.seen[opts.Root] = true
+		f := w.fetch(opts.Root)
+		<-f.done
+		if f.err != nil {
+			return f.err
+		}
+		items = f.items
+	}
+
+	var err error
+	if opts.Order == BreadthFirst {
+		err = w.breadthFirst(items)
+	} else {
+		err = w.depthFirst(items, nil)
+	}
+	if errors.Is(err, SkipFolder) {
+		err = nil
+	}
+	return err
+}
+
+func (w *folderWalker) fetch(uri string) *folderFetch {
+	f := &folderFetch{done: make(chan struct{})}
+	go func() {
+		defer close(f.done)
+		select {
+		case w.sem <- struct{}{}:
+		case <-w.stop:
+			f.err = errWalkStopped
+			return
+		}
+		defer func() { <-w.sem }()
+		f.items, f.err = NewIterator(func(opt ...CallOption) ([]*FolderItem, *Response, error) {
+			return w.s.ListFolderItems(uri, opt...)
+		}).All()
+	}()
+	return f
+}
+
+// visit calls fn for the item and reports whether the walk descends into it.
+func (w *folderWalker) visit(item *FolderItem, parents []*Folder) (bool, error) {
+	isFolder := item.Type == "folder" && item.Folder != nil
+	if isFolder {
+		if w.seen[item.Folder.URI] {
+			return false, nil
+		}
+		w.seen[item.Folder.URI] = true
+	}
+
+	err := w.fn(item, parents)
+	if errors.Is(err, SkipFolder) {
+		return false, nil
+	}
+	return isFolder && err == nil, err
+}
+
+func (w *folderWalker) depthFirst(items []*FolderItem, parents []*Folder) error {
+	for _, item := range items {
+		descend, err := w.visit(item, parents)
+		if err != nil {
+			return err
+		}
+		if !descend {
+			continue
+		}
+
+		f := w.fetch(item.Folder.URI)
+		<-f.done
+		if f.err != nil {
+			return fmt.Errorf("folder %s: %w", item.Folder.URI, f.err)
+		}
+
+		sub := append(parents[:len(parents):len(parents)], item.Folder)
+		if err := w.depthFirst(f.items, sub); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *folderWalker) breadthFirst(items []*FolderItem) error {
+	level := []*pendingFolder{{fetch: &folderFetch{items: items}}}
+
+	for len(level) > 0 {
+		var next []*pendingFolder
+		for _, p := range level {
+			if p.fetch.done != nil {
+				<-p.fetch.done
+			}
+			if p.fetch.err != nil {
+				return p.fetch.err
+			}
+
+			for _, item := range p.fetch.items {
+				descend, err := w.visit(item, p.parents)
+				if err != nil {
+					return err
+				}
+				if descend {
+					sub := append(p.parents[:len(p.parents):len(p.parents)], item.Folder)
+					next = append(next, &pendingFolder{fetch: w.fetch(item.Folder.URI), parents: sub})
+				}
+			}
+		}
+		level = next
+	}
+
+	return nil
+}
+
+// FolderTree represents a folder with its subfolders and videos.
+type FolderTree struct {
+	// Folder is nil for the root of a user's hierarchy.
+	Folder   *Folder
+	Children []*FolderTree
+	Videos   []*Video
+	// VideoCount and Duration (in seconds) cover the videos directly in the folder,
+	// TotalVideoCount and TotalDuration include the subfolders.
+	VideoCount      int
+	Duration        int
+	TotalVideoCount int
+	TotalDuration   int
+}
+
+// FolderTree walks the folder hierarchy of a user and returns it as a tree.
+// Passing the empty string will use the authenticated user.
+func (s *UsersService) FolderTree(uid string, opts *WalkOptions) (*FolderTree, error) {
+	root := &FolderTree{}
+	nodes := make(map[string]*FolderTree)
+
+	if opts != nil && opts.Root != "" {
+		root.Folder = &Folder{URI: opts.Root}
+	}
+
+	parentNode := func(parents []*Folder) *FolderTree {
+		if len(parents) == 0 {
+			return root
+		}
+		return nodes[parents[len(parents)-1].URI]
+	}
+
+	err := s.WalkFolders(uid, opts, func(item *FolderItem, parents []*Folder) error {
+		parent := parentNode(parents)
+		switch {
+		case item.Type == "folder" && item.Folder != nil:
+			node := &FolderTree{Folder: item.Folder}
+			nodes[item.Folder.URI] = node
+			parent.Children = append(parent.Children, node)
+		case item.Type == "video" && item.Video != nil:
+			parent.Videos = append(parent.Videos, item.Video)
+			parent.VideoCount++
+			parent.Duration += item.Video.Duration
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	root.sum()
+	return root, nil
+}
+
+func (t *FolderTree) sum() {
+	t.TotalVideoCount, t.TotalDuration = t.VideoCount, t.Duration
+	for _, c := range t.Children {
+		c.sum()
+		t.TotalVideoCount += c.TotalVideoCount
+		t.TotalDuration += c.TotalDuration
+	}
+}
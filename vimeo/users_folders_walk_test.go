@@ -0,0 +1,172 @@
+package vimeo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func setupFolderTree(t *testing.T) {
+	mux.HandleFunc("/me/folders", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"uri": "/users/1/projects/1", "name": "a"}, {"uri": "/users/1/projects/2", "name": "b"}]}`)
+	})
+	mux.HandleFunc("/users/1/projects/1/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"data": [
+				{"type": "video", "video": {"name": "v1", "duration": 10}},
+				{"type": "folder", "folder": {"uri": "/users/1/projects/3", "name": "c"}}
+			], "paging": {"next": "/users/1/projects/1/items?page=2"}}`)
+		default:
+			fmt.Fprint(w, `{"data": [{"type": "video", "video": {"name": "v2", "duration": 20}}]}`)
+		}
+	})
+	mux.HandleFunc("/users/1/projects/2/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"type": "video", "video": {"name": "v4", "duration": 7}}]}`)
+	})
+	mux.HandleFunc("/users/1/projects/3/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [
+			{"type": "folder", "folder": {"uri": "/users/1/projects/1", "name": "a"}},
+			{"type": "video", "video": {"name": "v3", "duration": 5}}
+		]}`)
+	})
+}
+
+func walkNames(t *testing.T, opts *WalkOptions, skip string) []string {
+	var names []string
+	err := client.Users.WalkFolders("", opts, func(item *FolderItem, parents []*Folder) error {
+		name := ""
+		for _, p := range parents {
+			name += p.Name + "/"
+		}
+		if item.Folder != nil {
+			name += item.Folder.Name
+		} else {
+			name += item.Video.Name
+		}
+		names = append(names, name)
+
+		if item.Folder != nil && item.Folder.Name == skip {
+			return SkipFolder
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Users.WalkFolders returned unexpected error: %v", err)
+	}
+	return names
+}
+
+func TestUsersService_WalkFolders(t *testing.T) {
+	setup()
+	defer teardown()
+	setupFolderTree(t)
+
+	names := walkNames(t, nil, "")
+	want := []string{"a", "a/v1", "a/c", "a/c/v3", "a/v2", "b", "b/v4"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Users.WalkFolders visited %v, want %v", names, want)
+	}
+}
+
+func TestUsersService_WalkFolders_breadthFirst(t *testing.T) {
+	setup()
+	defer teardown()
+	setupFolderTree(t)
+
+	names := walkNames(t, &WalkOptions{Order: BreadthFirst, Concurrency: 1}, "")
+	want := []string{"a", "b", "a/v1", "a/c", "a/v2", "b/v4", "a/c/v3"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Users.WalkFolders visited %v, want %v", names, want)
+	}
+}
+
+func TestUsersService_WalkFolders_skip(t *testing.T) {
+	setup()
+	defer teardown()
+	setupFolderTree(t)
+
+	names := walkNames(t, nil, "c")
+	want := []string{"a", "a/v1", "a/c", "a/v2", "b", "b/v4"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Users.WalkFolders visited %v, want %v", names, want)
+	}
+}
+
+func TestUsersService_WalkFolders_skipFetch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/folders", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": [{"uri": "/users/1/projects/1", "name": "a"}, {"uri": "/users/1/projects/2", "name": "b"}]}`)
+	})
+	mux.HandleFunc("/users/1/projects/1/items", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Users.WalkFolders fetched a skipped folder")
+	})
+	mux.HandleFunc("/users/1/projects/2/items", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": []}`)
+	})
+
+	for _, order := range []WalkOrder{DepthFirst, BreadthFirst} {
+		err := client.Users.WalkFolders("", &WalkOptions{Order: order}, func(item *FolderItem, parents []*Folder) error {
+			if item.Folder != nil && item.Folder.Name == "a" {
+				return fmt.Errorf("skip a: %w", SkipFolder)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Errorf("Users.WalkFolders returned unexpected error: %v", err)
+		}
+	}
+}
+
+func TestUsersService_WalkFolders_error(t *testing.T) {
+	setup()
+	defer teardown()
+	setupFolderTree(t)
+
+	stop := errors.New("stop")
+	n := 0
+	err := client.Users.WalkFolders("", nil, func(item *FolderItem, parents []*Folder) error {
+		n++
+		return stop
+	})
+	if err != stop {
+		t.Errorf("Users.WalkFolders returned %v, want %v", err, stop)
+	}
+	if n != 1 {
+		t.Errorf("Users.WalkFolders called fn %d times, want 1", n)
+	}
+}
+
+func TestUsersService_FolderTree(t *testing.T) {
+	setup()
+	defer teardown()
+	setupFolderTree(t)
+
+	tree, err := client.Users.FolderTree("", nil)
+	if err != nil {
+		t.Fatalf("Users.FolderTree returned unexpected error: %v", err)
+	}
+
+	if tree.TotalVideoCount != 4 || tree.TotalDuration != 42 {
+		t.Errorf("Users.FolderTree totals %d videos %d seconds, want 4 and 42", tree.TotalVideoCount, tree.TotalDuration)
+	}
+	if len(tree.Children) != 2 {
+		t.Fatalf("Users.FolderTree returned %d children, want 2", len(tree.Children))
+	}
+
+	a := tree.Children[0]
+	if a.Folder.Name != "a" || a.VideoCount != 2 || a.Duration != 30 || a.TotalVideoCount != 3 || a.TotalDuration != 35 {
+		t.Errorf("Users.FolderTree returned %+v for folder a", a)
+	}
+	if len(a.Children) != 1 || a.Children[0].Folder.Name != "c" || len(a.Children[0].Children) != 0 {
+		t.Errorf("Users.FolderTree returned children %+v for folder a", a.Children)
+	}
+}
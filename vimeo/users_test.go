@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -83,7 +85,7 @@ func TestUsersService_Edit(t *testing.T) {
 	defer teardown()
 
 	input := &UserRequest{
-		Name: "name",
+		Name: String("name"),
 	}
 
 	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
@@ -117,7 +119,7 @@ func TestUsersService_Edit_authenticatedUser(t *testing.T) {
 	defer teardown()
 
 	input := &UserRequest{
-		Name: "name",
+		Name: String("name"),
 	}
 
 	mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
@@ -199,8 +201,8 @@ func TestUsersService_CreateAlbum(t *testing.T) {
 	defer teardown()
 
 	input := &AlbumRequest{
-		Name:        "name",
-		Description: "desc",
+		Name:        String("name"),
+		Description: String("desc"),
 	}
 
 	mux.HandleFunc("/users/1/albums", func(w http.ResponseWriter, r *http.Request) {
@@ -234,8 +236,8 @@ func TestUsersService_CreateAlbum_authenticatedUser(t *testing.T) {
 	defer teardown()
 
 	input := &AlbumRequest{
-		Name:        "name",
-		Description: "desc",
+		Name:        String("name"),
+		Description: String("desc"),
 	}
 
 	mux.HandleFunc("/me/albums", func(w http.ResponseWriter, r *http.Request) {
@@ -311,11 +313,7 @@ func TestUsersService_EditAlbum(t *testing.T) {
 	setup()
 	defer teardown()
 
-	input := &AlbumRequest{
-		Name:        "name",
-		Description: "desc",
-		Privacy:     "anybody",
-	}
+	input := (&AlbumRequest{}).SetName("name").SetDescription("desc").SetPrivacy(AlbumAnybody)
 
 	mux.HandleFunc("/users/1/albums/a", func(w http.ResponseWriter, r *http.Request) {
 		v := &AlbumRequest{}
@@ -347,11 +345,7 @@ func TestUsersService_EditAlbum_authenticatedUser(t *testing.T) {
 	setup()
 	defer teardown()
 
-	input := &AlbumRequest{
-		Name:        "name",
-		Description: "desc",
-		Privacy:     "anybody",
-	}
+	input := (&AlbumRequest{}).SetName("name").SetDescription("desc").SetPrivacy(AlbumAnybody)
 
 	mux.HandleFunc("/me/albums/a", func(w http.ResponseWriter, r *http.Request) {
 		v := &AlbumRequest{}
@@ -551,6 +545,310 @@ func TestUsersService_AlbumDeleteVideo_authenticatedUser(t *testing.T) {
 	}
 }
 
+func TestUsersService_AlbumSetThumbnail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a/videos/1/set_album_thumbnail", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"time_code":12.5}`+"\n")
+		fmt.Fprint(w, `{"name": "Test", "has_chosen_thumbnail": true}`)
+	})
+
+	album, _, err := client.Users.AlbumSetThumbnail("", "a", 1, 12.5)
+	if err != nil {
+		t.Errorf("Users.AlbumSetThumbnail returned unexpected error: %v", err)
+	}
+
+	want := &Album{Name: "Test", HasChosenThumbnail: true}
+	if !reflect.DeepEqual(album, want) {
+		t.Errorf("Users.AlbumSetThumbnail returned %+v, want %+v", album, want)
+	}
+}
+
+func TestUsersService_AlbumSetFeaturedVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums/a/videos/1/set_featured_video", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"name": "Test", "layout": "player", "theme": "dark", "brand_color": "#ff0000", "hide_nav": true}`)
+	})
+
+	album, _, err := client.Users.AlbumSetFeaturedVideo("1", "a", 1)
+	if err != nil {
+		t.Errorf("Users.AlbumSetFeaturedVideo returned unexpected error: %v", err)
+	}
+
+	want := &Album{Name: "Test", Layout: "player", Theme: "dark", BrandColor: "#ff0000", HideNav: true}
+	if !reflect.DeepEqual(album, want) {
+		t.Errorf("Users.AlbumSetFeaturedVideo returned %+v, want %+v", album, want)
+	}
+}
+
+func TestUsersService_AlbumReorderVideos(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+
+	mux.HandleFunc("/me/albums/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"sort":"arranged"}`+"\n")
+		calls = append(calls, "sort")
+		fmt.Fprint(w, `{"sort": "arranged"}`)
+	})
+
+	mux.HandleFunc("/me/albums/a/videos", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			calls = append(calls, "list")
+			fmt.Fprint(w, `{"data": [{"uri": "/videos/1"}, {"uri": "/videos/4"}, {"uri": "/videos/2"}, {"uri": "/videos/3"}]}`)
+			return
+		}
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"videos":"/videos/3,/videos/1,/videos/2,/videos/4"}`+"\n")
+		calls = append(calls, "replace")
+	})
+
+	_, err := client.Users.AlbumReorderVideos("", "a", []int{3, 1, 2})
+	if err != nil {
+		t.Errorf("Users.AlbumReorderVideos returned unexpected error: %v", err)
+	}
+
+	if want := []string{"list", "sort", "replace"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("Users.AlbumReorderVideos sent %v, want %v", calls, want)
+	}
+}
+
+func TestUsersService_AlbumUploadLogo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a/logos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprintf(w, `{"uri": "/users/1/albums/a/logos/2", "link": "%s/upload/2"}`, server.URL)
+	})
+	mux.HandleFunc("/upload/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, "png")
+	})
+	mux.HandleFunc("/me/albums/a/logos/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"active":true}`+"\n")
+		fmt.Fprint(w, `{"uri": "/users/1/albums/a/logos/2", "active": true}`)
+	})
+
+	f, err := os.CreateTemp(t.TempDir(), "logo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString("png"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Seek(0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	logo, _, err := client.Users.AlbumUploadLogo("", "a", f)
+	if err != nil {
+		t.Errorf("Users.AlbumUploadLogo returned unexpected error: %v", err)
+	}
+
+	want := &Pictures{URI: "/users/1/albums/a/logos/2", Active: true}
+	if !reflect.DeepEqual(logo, want) {
+		t.Errorf("Users.AlbumUploadLogo returned %+v, want %+v", logo, want)
+	}
+}
+
+func TestUsersService_AlbumUploadLogo_dir(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a/logos", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Users.AlbumUploadLogo created a logo for a directory")
+	})
+
+	f, err := os.Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if _, _, err := client.Users.AlbumUploadLogo("", "a", f); err == nil {
+		t.Errorf("Users.AlbumUploadLogo expected error")
+	}
+}
+
+func TestUsersService_AlbumEditLogo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a/logos/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"active":true}`+"\n")
+		fmt.Fprint(w, `{"uri": "/users/1/albums/a/logos/2", "active": true}`)
+	})
+
+	logo, _, err := client.Users.AlbumEditLogo("", "a", 2, &AlbumLogoRequest{Active: Bool(true)})
+	if err != nil {
+		t.Errorf("Users.AlbumEditLogo returned unexpected error: %v", err)
+	}
+
+	want := &Pictures{URI: "/users/1/albums/a/logos/2", Active: true}
+	if !reflect.DeepEqual(logo, want) {
+		t.Errorf("Users.AlbumEditLogo returned %+v, want %+v", logo, want)
+	}
+}
+
+func TestUsersService_AlbumListLogo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums/a/logos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"uri": "/users/1/albums/a/logos/2"}]}`)
+	})
+
+	logos, _, err := client.Users.AlbumListLogo("1", "a")
+	if err != nil {
+		t.Errorf("Users.AlbumListLogo returned unexpected error: %v", err)
+	}
+
+	want := []*Pictures{{URI: "/users/1/albums/a/logos/2"}}
+	if !reflect.DeepEqual(logos, want) {
+		t.Errorf("Users.AlbumListLogo returned %+v, want %+v", logos, want)
+	}
+}
+
+func TestUsersService_AlbumListUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a/privacy/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	users, _, err := client.Users.AlbumListUser("", "a")
+	if err != nil {
+		t.Errorf("Users.AlbumListUser returned unexpected error: %v", err)
+	}
+
+	want := []*User{{Name: "Test"}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("Users.AlbumListUser returned %+v, want %+v", users, want)
+	}
+}
+
+func TestUsersService_AlbumAllowUsers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums/a/privacy/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `[{"uri":"/users/2"},{"uri":"/users/3"}]`+"\n")
+		fmt.Fprint(w, `[{"uri": "/users/2"}, {"uri": "/users/3"}]`)
+	})
+
+	users, _, err := client.Users.AlbumAllowUsers("1", "a", []string{"2", "3"})
+	if err != nil {
+		t.Errorf("Users.AlbumAllowUsers returned unexpected error: %v", err)
+	}
+
+	want := []*User{{URI: "/users/2"}, {URI: "/users/3"}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("Users.AlbumAllowUsers returned %+v, want %+v", users, want)
+	}
+}
+
+func TestUsersService_AlbumAllowUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a/privacy/users/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	_, err := client.Users.AlbumAllowUser("", "a", "2")
+	if err != nil {
+		t.Errorf("Users.AlbumAllowUser returned unexpected error: %v", err)
+	}
+}
+
+func TestUsersService_AlbumDisallowUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a/privacy/users/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Users.AlbumDisallowUser("", "a", "https://vimeo.com/user2")
+	if err != nil {
+		t.Errorf("Users.AlbumDisallowUser returned unexpected error: %v", err)
+	}
+}
+
+func TestUsersService_AlbumAllowUser_invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, user := range []string{"", "2/../../videos/1", "https://vimeo.com/1"} {
+		if _, err := client.Users.AlbumAllowUser("", "a", user); err == nil {
+			t.Errorf("Users.AlbumAllowUser(%q) expected error", user)
+		}
+	}
+}
+
+func TestUsersService_AlbumListDomain(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums/a/privacy/domains", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "example.com"}]}`)
+	})
+
+	domains, _, err := client.Users.AlbumListDomain("1", "a")
+	if err != nil {
+		t.Errorf("Users.AlbumListDomain returned unexpected error: %v", err)
+	}
+
+	want := []*Domain{{Name: "example.com"}}
+	if !reflect.DeepEqual(domains, want) {
+		t.Errorf("Users.AlbumListDomain returned %+v, want %+v", domains, want)
+	}
+}
+
+func TestUsersService_AlbumAllowDomain(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a/privacy/domains/example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	_, err := client.Users.AlbumAllowDomain("", "a", "example.com")
+	if err != nil {
+		t.Errorf("Users.AlbumAllowDomain returned unexpected error: %v", err)
+	}
+}
+
+func TestUsersService_AlbumDisallowDomain(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a/privacy/domains/example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Users.AlbumDisallowDomain("", "a", "example.com")
+	if err != nil {
+		t.Errorf("Users.AlbumDisallowDomain returned unexpected error: %v", err)
+	}
+}
+
 func TestUsersService_ListAppearance(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1566,6 +1864,109 @@ func TestUsersService_GetPreset_authenticatedUser(t *testing.T) {
 	}
 }
 
+func TestUsersService_CreatePreset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/presets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"Brand","settings":{"logos":{"vimeo":false},"color":"ff0000"}}`+"\n")
+		fmt.Fprint(w, `{"uri": "/users/1/presets/2", "name": "Brand", "settings": {"color": "ff0000"}}`)
+	})
+
+	preset, _, err := client.Users.CreatePreset("", &PresetRequest{
+		Name:     String("Brand"),
+		Settings: &EmbedSettings{Logos: &Logos{Vimeo: Bool(false)}, Color: String("ff0000")},
+	})
+	if err != nil {
+		t.Errorf("Users.CreatePreset returned unexpected error: %v", err)
+	}
+
+	want := &Preset{URI: "/users/1/presets/2", Name: "Brand", Settings: &EmbedSettings{Color: String("ff0000")}}
+	if !reflect.DeepEqual(preset, want) {
+		t.Errorf("Users.CreatePreset returned %+v, want %+v", preset, want)
+	}
+	if id := preset.GetID(); id != 2 {
+		t.Errorf("Preset.GetID returned %+v, want %+v", id, 2)
+	}
+}
+
+func TestUsersService_EditPreset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/presets/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"name":"Test"}`+"\n")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	preset, _, err := client.Users.EditPreset("1", 2, &PresetRequest{Name: String("Test")})
+	if err != nil {
+		t.Errorf("Users.EditPreset returned unexpected error: %v", err)
+	}
+
+	want := &Preset{Name: "Test"}
+	if !reflect.DeepEqual(preset, want) {
+		t.Errorf("Users.EditPreset returned %+v, want %+v", preset, want)
+	}
+}
+
+func TestUsersService_DeletePreset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/presets/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Users.DeletePreset("", 2)
+	if err != nil {
+		t.Errorf("Users.DeletePreset returned unexpected error: %v", err)
+	}
+}
+
+func TestUsersService_UploadPresetLogo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/customlogos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprintf(w, `{"uri": "/users/1/customlogos/5", "link": "%s/upload/5"}`, server.URL)
+	})
+	mux.HandleFunc("/upload/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, "png")
+	})
+	mux.HandleFunc("/me/presets/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"settings":{"logos":{"custom":true}},"custom_logo":{"id":5,"active":true}}`+"\n")
+		fmt.Fprint(w, `{"name": "Brand"}`)
+	})
+
+	f, err := os.CreateTemp(t.TempDir(), "logo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString("png"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Seek(0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	preset, _, err := client.Users.UploadPresetLogo("", 2, f)
+	if err != nil {
+		t.Errorf("Users.UploadPresetLogo returned unexpected error: %v", err)
+	}
+
+	want := &Preset{Name: "Brand"}
+	if !reflect.DeepEqual(preset, want) {
+		t.Errorf("Users.UploadPresetLogo returned %+v, want %+v", preset, want)
+	}
+}
+
 func TestUsersService_PresetListVideo(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1845,3 +2246,108 @@ func TestUsersService_WatchLaterDeleteVideo_authenticatedUser(t *testing.T) {
 		t.Errorf("Users.WatchLaterDeleteVideo returned unexpected error: %v", err)
 	}
 }
+
+func TestUsersService_CreateFolder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/folders", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"Sub","parent_folder_uri":"/users/1/projects/2"}`+"\n")
+		fmt.Fprint(w, `{"uri": "/users/1/projects/3", "name": "Sub", "parent_folder": {"uri": "/users/1/projects/2"}}`)
+	})
+
+	folder, _, err := client.Users.CreateFolder("", &FolderRequest{Name: String("Sub"), ParentFolderURI: String("/users/1/projects/2")})
+	if err != nil {
+		t.Errorf("Users.CreateFolder returned unexpected error: %v", err)
+	}
+
+	want := &Folder{URI: "/users/1/projects/3", Name: "Sub", ParentFolder: &Folder{URI: "/users/1/projects/2"}}
+	if !reflect.DeepEqual(folder, want) {
+		t.Errorf("Users.CreateFolder returned %+v, want %+v", folder, want)
+	}
+}
+
+func TestUsersService_MoveFolder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/projects/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"parent_folder_uri":"/users/1/projects/4"}`+"\n")
+		fmt.Fprint(w, `{"uri": "/users/1/projects/3"}`)
+	})
+
+	_, _, err := client.Users.MoveFolder("/users/1/projects/3", "/users/1/projects/4")
+	if err != nil {
+		t.Errorf("Users.MoveFolder returned unexpected error: %v", err)
+	}
+}
+
+func TestUsersService_DeleteFolder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/projects/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testFormURLValues(t, r, values{"should_delete_clips": "true"})
+	})
+
+	_, err := client.Users.DeleteFolder("/users/1/projects/3", true)
+	if err != nil {
+		t.Errorf("Users.DeleteFolder returned unexpected error: %v", err)
+	}
+}
+
+func TestUsersService_AddVideoToFolder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/projects/3/videos/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	_, err := client.Users.AddVideoToFolder("/users/1/projects/3", 7)
+	if err != nil {
+		t.Errorf("Users.AddVideoToFolder returned unexpected error: %v", err)
+	}
+}
+
+func TestUsersService_RemoveVideoFromFolder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/projects/3/videos/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Users.RemoveVideoFromFolder("/users/1/projects/3", 7)
+	if err != nil {
+		t.Errorf("Users.RemoveVideoFromFolder returned unexpected error: %v", err)
+	}
+}
+
+func TestUsersService_MoveVideosToFolder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var batches []int
+	mux.HandleFunc("/users/1/projects/3/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		batches = append(batches, len(strings.Split(r.URL.Query().Get("uris"), ",")))
+	})
+
+	vids := make([]int, 150)
+	for i := range vids {
+		vids[i] = i + 1
+	}
+
+	_, err := client.Users.MoveVideosToFolder("/users/1/projects/3", vids)
+	if err != nil {
+		t.Errorf("Users.MoveVideosToFolder returned unexpected error: %v", err)
+	}
+
+	if want := []int{100, 50}; !reflect.DeepEqual(batches, want) {
+		t.Errorf("Users.MoveVideosToFolder sent batches of %v, want %v", batches, want)
+	}
+}
@@ -15,19 +15,127 @@ import (
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos
 type VideosService service
 
-type dataListVideo struct {
-	Data []*Video `json:"data,omitempty"`
-	pagination
+// Embed internal object provides access to HTML embed code and player settings.
+type Embed struct {
+	URI     string       `json:"uri,omitempty"`
+	HTML    string       `json:"html,omitempty"`
+	Badges  *EmbedBadges `json:"badges,omitempty"`
+	Buttons *Buttons     `json:"buttons,omitempty"`
+	Logos   *EmbedLogos  `json:"logos,omitempty"`
+	Title   *EmbedTitle  `json:"title,omitempty"`
+	Color   string       `json:"color,omitempty"`
+	PlayBar bool         `json:"playbar"`
+	Volume  bool         `json:"volume"`
+	Speed   bool         `json:"speed"`
 }
 
-// Embed internal object provides access to HTML embed code.
-type Embed struct {
-	HTML string `json:"html,omitempty"`
+// EmbedBadges internal object provides access to the badges shown on the player.
+type EmbedBadges struct {
+	HDR              bool                 `json:"hdr"`
+	Live             *EmbedLiveBadge      `json:"live,omitempty"`
+	StaffPick        *EmbedStaffPickBadge `json:"staff_pick,omitempty"`
+	VOD              bool                 `json:"vod"`
+	WeekendChallenge bool                 `json:"weekend_challenge"`
+}
+
+// EmbedLiveBadge internal object embed badges.
+type EmbedLiveBadge struct {
+	Streaming bool `json:"streaming"`
+	Archived  bool `json:"archived"`
+}
+
+// EmbedStaffPickBadge internal object embed badges.
+type EmbedStaffPickBadge struct {
+	Normal         bool `json:"normal"`
+	BestOfTheMonth bool `json:"best_of_the_month"`
+	BestOfTheYear  bool `json:"best_of_the_year"`
+	Premiere       bool `json:"premiere"`
+}
+
+// EmbedLogos internal object provides access to the logos shown on the player.
+type EmbedLogos struct {
+	Vimeo  bool             `json:"vimeo"`
+	Custom *EmbedCustomLogo `json:"custom,omitempty"`
+}
+
+// EmbedCustomLogo internal object embed logos.
+type EmbedCustomLogo struct {
+	Active bool   `json:"active"`
+	URL    string `json:"url,omitempty"`
+	Link   string `json:"link,omitempty"`
+	Sticky bool   `json:"sticky"`
+}
+
+// EmbedTitle internal object provides access to the title settings of the player.
+type EmbedTitle struct {
+	Name     string `json:"name,omitempty"`
+	Owner    string `json:"owner,omitempty"`
+	Portrait string `json:"portrait,omitempty"`
+}
+
+// Play internal object provides access to the playback links of the video.
+type Play struct {
+	Status      string             `json:"status,omitempty"`
+	Progressive []*PlayProgressive `json:"progressive,omitempty"`
+	HLS         *PlayStream        `json:"hls,omitempty"`
+	DASH        *PlayStream        `json:"dash,omitempty"`
+}
+
+// PlayProgressive internal object provides access to a progressive playback file.
+type PlayProgressive struct {
+	Type               string    `json:"type,omitempty"`
+	Codec              string    `json:"codec,omitempty"`
+	Rendition          string    `json:"rendition,omitempty"`
+	Width              int       `json:"width,omitempty"`
+	Height             int       `json:"height,omitempty"`
+	Link               string    `json:"link,omitempty"`
+	LinkExpirationTime time.Time `json:"link_expiration_time,omitempty"`
+	Log                string    `json:"log,omitempty"`
+	CreatedTime        time.Time `json:"created_time,omitempty"`
+	FPS                float64   `json:"fps,omitempty"`
+	Size               int       `json:"size,omitempty"`
+	MD5                string    `json:"md5,omitempty"`
+}
+
+// PlayStream internal object provides access to an adaptive (HLS or DASH) playback link.
+type PlayStream struct {
+	Link               string    `json:"link,omitempty"`
+	LinkExpirationTime time.Time `json:"link_expiration_time,omitempty"`
+	Log                string    `json:"log,omitempty"`
+}
+
+// Spatial internal object provides access to the 360 settings of the video.
+type Spatial struct {
+	Projection       string                     `json:"projection,omitempty"`
+	StereoFormat     string                     `json:"stereo_format,omitempty"`
+	FieldOfView      int                        `json:"field_of_view,omitempty"`
+	DirectorTimeline []*SpatialDirectorTimeline `json:"director_timeline,omitempty"`
+}
+
+// SpatialDirectorTimeline internal object describes a point of view at a given time code.
+type SpatialDirectorTimeline struct {
+	Pitch    float64 `json:"pitch"`
+	Roll     float64 `json:"roll"`
+	Yaw      float64 `json:"yaw"`
+	TimeCode float64 `json:"time_code"`
+}
+
+// ReviewPage internal object provides access to the review page of the video.
+type ReviewPage struct {
+	Active bool   `json:"active"`
+	Link   string `json:"link,omitempty"`
+}
+
+// VideoUploader internal object provides access to the user who uploaded the video.
+type VideoUploader struct {
+	Pictures *Pictures `json:"pictures,omitempty"`
 }
 
 // Stats internal object provides access to video statistic.
 type Stats struct {
-	Plays int `json:"plays,omitempty"`
+	Plays    int `json:"plays,omitempty"`
+	Likes    int `json:"likes,omitempty"`
+	Comments int `json:"comments,omitempty"`
 }
 
 // File internal object provides access to video file information
@@ -67,45 +175,50 @@ type App struct {
 }
 
 // Buttons internal object embed settings.
+// Nil fields are left out of requests, except Fullscreen and Scaling which are always sent.
 type Buttons struct {
-	Like       bool `json:"like"`
-	WatchLater bool `json:"watchlater"`
-	Share      bool `json:"share"`
-	Embed      bool `json:"embed"`
-	Vote       bool `json:"vote"`
-	HD         bool `json:"HD"`
+	Like       *bool `json:"like,omitempty"`
+	WatchLater *bool `json:"watchlater,omitempty"`
+	Share      *bool `json:"share,omitempty"`
+	Embed      *bool `json:"embed,omitempty"`
+	Vote       *bool `json:"vote,omitempty"`
+	HD         *bool `json:"HD,omitempty"`
+	Fullscreen *bool `json:"fullscreen"`
+	Scaling    *bool `json:"scaling"`
 }
 
 // Logos internal object embed settings.
+// Nil fields are left out of requests.
 type Logos struct {
-	Vimeo        bool `json:"vimeo"`
-	Custom       bool `json:"custom"`
-	StickyCustom bool `json:"sticky_custom"`
+	Vimeo        *bool `json:"vimeo,omitempty"`
+	Custom       *bool `json:"custom,omitempty"`
+	StickyCustom *bool `json:"sticky_custom,omitempty"`
 }
 
 // EmbedSettings internal object provides access to embed settings.
+// Nil fields are left out of requests.
 type EmbedSettings struct {
 	Buttons                         *Buttons `json:"buttons,omitempty"`
 	Logos                           *Logos   `json:"logos,omitempty"`
-	Outro                           string   `json:"outro,omitempty"`
-	Portrait                        string   `json:"portrait,omitempty"`
-	Title                           string   `json:"title,omitempty"`
-	ByLine                          string   `json:"byline,omitempty"`
-	Badge                           bool     `json:"badge"`
-	ByLineBadge                     bool     `json:"byline_badge"`
-	CollectionsButton               bool     `json:"collections_button"`
-	PlayBar                         bool     `json:"playbar"`
-	Volume                          bool     `json:"volume"`
-	FullscreenButton                bool     `json:"fullscreen_button"`
-	ScalingButton                   bool     `json:"scaling_button"`
-	Autoplay                        bool     `json:"autoplay"`
-	Autopause                       bool     `json:"autopause"`
-	Loop                            bool     `json:"loop"`
-	Color                           string   `json:"color,omitempty"`
-	Link                            bool     `json:"link"`
-	OverlayEmailCapture             int      `json:"overlay_email_capture,omitempty"`
-	OverlayEmailCaptureText         string   `json:"overlay_email_capture_text,omitempty"`
-	OverlayEmailCaptureConfirmation string   `json:"overlay_email_capture_confirmation,omitempty"`
+	Outro                           *string  `json:"outro,omitempty"`
+	Portrait                        *string  `json:"portrait,omitempty"`
+	Title                           *string  `json:"title,omitempty"`
+	ByLine                          *string  `json:"byline,omitempty"`
+	Badge                           *bool    `json:"badge,omitempty"`
+	ByLineBadge                     *bool    `json:"byline_badge,omitempty"`
+	CollectionsButton               *bool    `json:"collections_button,omitempty"`
+	PlayBar                         *bool    `json:"playbar,omitempty"`
+	Volume                          *bool    `json:"volume,omitempty"`
+	FullscreenButton                *bool    `json:"fullscreen_button,omitempty"`
+	ScalingButton                   *bool    `json:"scaling_button,omitempty"`
+	Autoplay                        *bool    `json:"autoplay,omitempty"`
+	Autopause                       *bool    `json:"autopause,omitempty"`
+	Loop                            *bool    `json:"loop,omitempty"`
+	Color                           *string  `json:"color,omitempty"`
+	Link                            *bool    `json:"link,omitempty"`
+	OverlayEmailCapture             *int     `json:"overlay_email_capture,omitempty"`
+	OverlayEmailCaptureText         *string  `json:"overlay_email_capture_text,omitempty"`
+	OverlayEmailCaptureConfirmation *string  `json:"overlay_email_capture_confirmation,omitempty"`
 }
 
 // EmbedPresets internal object present settings.
@@ -134,168 +247,217 @@ type TransCode struct {
 
 // Video represents a video.
 type Video struct {
-	URI           string        `json:"uri,omitempty"`
-	Name          string        `json:"name,omitempty"`
-	Description   string        `json:"description,omitempty"`
-	Link          string        `json:"link,omitempty"`
-	Duration      int           `json:"duration,omitempty"`
-	Width         int           `json:"width,omitempty"`
-	Height        int           `json:"height,omitempty"`
-	Language      string        `json:"language,omitempty"`
-	Embed         *Embed        `json:"embed,omitempty"`
-	CreatedTime   time.Time     `json:"created_time,omitempty"`
-	ModifiedTime  time.Time     `json:"modified_time,omitempty"`
-	ReleaseTime   time.Time     `json:"release_time,omitempty"`
-	ContentRating []string      `json:"content_rating,omitempty"`
-	License       string        `json:"license,omitempty"`
-	Privacy       *Privacy      `json:"privacy,omitempty"`
-	Pictures      *Pictures     `json:"pictures,omitempty"`
-	Tags          []*Tag        `json:"tags,omitempty"`
-	Stats         *Stats        `json:"stats,omitempty"`
-	Categories    []*Category   `json:"categories,omitempty"`
-	User          *User         `json:"user,omitempty"`
-	Files         []*File       `json:"files,omitempty"`
-	Download      []*Download   `json:"download,omitempty"`
-	App           *App          `json:"app,omitempty"`
-	Status        string        `json:"status,omitempty"`
-	ResourceKey   string        `json:"resource_key,omitempty"`
-	EmbedPresets  *EmbedPresets `json:"embed_presets,omitempty"`
-	Upload        *Upload       `json:"upload,omitempty"`
-	TransCode     *TransCode    `json:"transcode,omitempty"`
+	URI                     string              `json:"uri,omitempty"`
+	Type                    string              `json:"type,omitempty"`
+	Name                    string              `json:"name,omitempty"`
+	Description             string              `json:"description,omitempty"`
+	Link                    string              `json:"link,omitempty"`
+	PlayerEmbedURL          string              `json:"player_embed_url,omitempty"`
+	ManageLink              string              `json:"manage_link,omitempty"`
+	Duration                int                 `json:"duration,omitempty"`
+	Width                   int                 `json:"width,omitempty"`
+	Height                  int                 `json:"height,omitempty"`
+	Language                string              `json:"language,omitempty"`
+	Embed                   *Embed              `json:"embed,omitempty"`
+	CreatedTime             time.Time           `json:"created_time,omitempty"`
+	ModifiedTime            time.Time           `json:"modified_time,omitempty"`
+	ReleaseTime             time.Time           `json:"release_time,omitempty"`
+	LastUserActionEventDate time.Time           `json:"last_user_action_event_date,omitempty"`
+	ContentRating           []ContentRatingCode `json:"content_rating,omitempty"`
+	ContentRatingClass      string              `json:"content_rating_class,omitempty"`
+	RatingModLocked         bool                `json:"rating_mod_locked"`
+	License                 License             `json:"license,omitempty"`
+	Privacy                 *VideoPrivacy       `json:"privacy,omitempty"`
+	Password                string              `json:"password,omitempty"`
+	Pictures                *Pictures           `json:"pictures,omitempty"`
+	Tags                    []*Tag              `json:"tags,omitempty"`
+	Stats                   *Stats              `json:"stats,omitempty"`
+	Categories              []*Category         `json:"categories,omitempty"`
+	Metadata                *Metadata           `json:"metadata,omitempty"`
+	User                    *User               `json:"user,omitempty"`
+	Uploader                *VideoUploader      `json:"uploader,omitempty"`
+	ParentFolder            *Folder             `json:"parent_folder,omitempty"`
+	ReviewPage              *ReviewPage         `json:"review_page,omitempty"`
+	Play                    *Play               `json:"play,omitempty"`
+	Spatial                 *Spatial            `json:"spatial,omitempty"`
+	Files                   []*File             `json:"files,omitempty"`
+	Download                []*Download         `json:"download,omitempty"`
+	App                     *App                `json:"app,omitempty"`
+	Status                  string              `json:"status,omitempty"`
+	IsPlayable              bool                `json:"is_playable"`
+	HasAudio                bool                `json:"has_audio"`
+	ResourceKey             string              `json:"resource_key,omitempty"`
+	EmbedPresets            *EmbedPresets       `json:"embed_presets,omitempty"`
+	Upload                  *Upload             `json:"upload,omitempty"`
+	TransCode               *TransCode          `json:"transcode,omitempty"`
+	Live                    *VideoLive          `json:"live,omitempty"`
 }
 
 // TitleRequest a request to edit an embed settings.
 type TitleRequest struct {
-	Owner    string `json:"owner,omitempty"`
-	Portrait string `json:"portrait,omitempty"`
-	Name     string `json:"name,omitempty"`
+	Owner    *string `json:"owner,omitempty"`
+	Portrait *string `json:"portrait,omitempty"`
+	Name     *string `json:"name,omitempty"`
 }
 
 // RatingTVRequest a request to edit video.
 type RatingTVRequest struct {
-	Rating string `json:"rating,omitempty"`
-	Reason string `json:"reason,omitempty"`
+	Rating *string `json:"rating,omitempty"`
+	Reason *string `json:"reason,omitempty"`
 }
 
 // RatingMPAARequest a request to edit video.
 type RatingMPAARequest struct {
-	Rating string `json:"rating,omitempty"`
-	Reason string `json:"reason,omitempty"`
+	Rating *string `json:"rating,omitempty"`
+	Reason *string `json:"reason,omitempty"`
 }
 
 // RatingsRequest a request to edit an embed settings.
 type RatingsRequest struct {
-	RatingTVRequest   string `json:"tv,omitempty"`
-	RatingMPAARequest string `json:"mpaa,omitempty"`
+	RatingTVRequest   *RatingTVRequest   `json:"tv,omitempty"`
+	RatingMPAARequest *RatingMPAARequest `json:"mpaa,omitempty"`
 }
 
 // ExtraLinksRequest a request to edit video.
 type ExtraLinksRequest struct {
-	IMDB           string `json:"imdb,omitempty"`
-	RottenTomatoes string `json:"rotten_tomatoes,omitempty"`
+	IMDB           *string `json:"imdb,omitempty"`
+	RottenTomatoes *string `json:"rotten_tomatoes,omitempty"`
 }
 
 // EmbedRequest a request to edit an embed settings.
+// Nil fields are left out of the request, so the current settings are kept.
 type EmbedRequest struct {
 	Buttons                         *Buttons           `json:"buttons,omitempty"`
 	Logos                           *Logos             `json:"logos,omitempty"`
-	Outro                           string             `json:"outro,omitempty"`
-	Portrait                        string             `json:"portrait,omitempty"`
+	Outro                           *string            `json:"outro,omitempty"`
+	Portrait                        *string            `json:"portrait,omitempty"`
 	Title                           *TitleRequest      `json:"title,omitempty"`
-	ByLine                          string             `json:"byline,omitempty"`
-	Badge                           bool               `json:"badge"`
-	ByLineBadge                     bool               `json:"byline_badge"`
-	CollectionsButton               bool               `json:"collections_button"`
-	PlayBar                         bool               `json:"playbar"`
-	Volume                          bool               `json:"volume"`
-	FullscreenButton                bool               `json:"fullscreen_button"`
-	ScalingButton                   bool               `json:"scaling_button"`
-	Autoplay                        bool               `json:"autoplay"`
-	Autopause                       bool               `json:"autopause"`
-	Loop                            bool               `json:"loop"`
-	Color                           string             `json:"color,omitempty"`
-	Link                            bool               `json:"link"`
+	ByLine                          *string            `json:"byline,omitempty"`
+	Badge                           *bool              `json:"badge,omitempty"`
+	ByLineBadge                     *bool              `json:"byline_badge,omitempty"`
+	CollectionsButton               *bool              `json:"collections_button,omitempty"`
+	PlayBar                         *bool              `json:"playbar,omitempty"`
+	Volume                          *bool              `json:"volume,omitempty"`
+	FullscreenButton                *bool              `json:"fullscreen_button,omitempty"`
+	ScalingButton                   *bool              `json:"scaling_button,omitempty"`
+	Autoplay                        *bool              `json:"autoplay,omitempty"`
+	Autopause                       *bool              `json:"autopause,omitempty"`
+	Loop                            *bool              `json:"loop,omitempty"`
+	Color                           *string            `json:"color,omitempty"`
+	Link                            *bool              `json:"link,omitempty"`
 	RatingsRequest                  *RatingsRequest    `json:"ratings,omitempty"`
 	ExtraLinks                      *ExtraLinksRequest `json:"external_links,omitempty"`
-	OverlayEmailCapture             int                `json:"overlay_email_capture,omitempty"`
-	OverlayEmailCaptureText         string             `json:"overlay_email_capture_text,omitempty"`
-	OverlayEmailCaptureConfirmation string             `json:"overlay_email_capture_confirmation,omitempty"`
+	OverlayEmailCapture             *int               `json:"overlay_email_capture,omitempty"`
+	OverlayEmailCaptureText         *string            `json:"overlay_email_capture_text,omitempty"`
+	OverlayEmailCaptureConfirmation *string            `json:"overlay_email_capture_confirmation,omitempty"`
 }
 
 // ReviewPageRequest represents a request to edit an video.
 type ReviewPageRequest struct {
-	Active bool `json:"name,omitempty"`
+	Active *bool `json:"active,omitempty"`
+}
+
+// PrivacyRequest represents a request to edit the privacy of a video.
+type PrivacyRequest struct {
+	View     *ViewPrivacy    `json:"view,omitempty"`
+	Embed    *EmbedPrivacy   `json:"embed,omitempty"`
+	Comment  *CommentPrivacy `json:"comment,omitempty"`
+	Download *bool           `json:"download,omitempty"`
+	Add      *bool           `json:"add,omitempty"`
 }
 
 // VideoRequest represents a request to edit an video.
+// Nil fields are left out of the request, so Edit changes only what was set.
 type VideoRequest struct {
-	Name          string             `json:"name,omitempty"`
-	Description   string             `json:"description,omitempty"`
-	License       string             `json:"license,omitempty"`
-	Privacy       *Privacy           `json:"privacy,omitempty"`
-	Password      string             `json:"password,omitempty"`
-	Locale        string             `json:"locale,omitempty"`
-	ContentRating []string           `json:"content_rating,omitempty"`
-	Embed         *EmbedRequest      `json:"embed,omitempty"`
-	ReviewPage    *ReviewPageRequest `json:"review_page,omitempty"`
+	Name          *string             `json:"name,omitempty"`
+	Description   *string             `json:"description,omitempty"`
+	License       *License            `json:"license,omitempty"`
+	Privacy       *PrivacyRequest     `json:"privacy,omitempty"`
+	Password      *string             `json:"password,omitempty"`
+	Locale        *string             `json:"locale,omitempty"`
+	ContentRating []ContentRatingCode `json:"content_rating,omitempty"`
+	Embed         *EmbedRequest       `json:"embed,omitempty"`
+	ReviewPage    *ReviewPageRequest  `json:"review_page,omitempty"`
 }
 
 // GetID returns the numeric identifier (ID) of the video.
 func (v Video) GetID() int {
+	if ref, err := ParseRef(v.URI); err == nil && ref.Kind == RefVideo {
+		ID, _ := strconv.Atoi(ref.ID)
+		return ID
+	}
+
 	l := strings.SplitN(v.URI, "/", -1)
 	ID, _ := strconv.Atoi(l[len(l)-1])
 	return ID
 }
 
-// UploadVideoRequest specifies the optional parameters to the
-// uploadVideo method.
-type UploadVideoRequest struct {
-	Name   string  `json:"name"`
-	Upload *Upload `json:"upload,omitempty"`
+// VideoID identifies a video together with the privacy hash of an unlisted video.
+type VideoID struct {
+	ID   int
+	Hash string
 }
 
-func listVideo(c *Client, url string, opt ...CallOption) ([]*Video, *Response, error) {
-	u, err := addOptions(url, opt...)
+// ParseVideoID parses a video link, an API URI or a "123:abcdef" identifier into a VideoID.
+func ParseVideoID(s string) (VideoID, error) {
+	ref, err := ParseRef(s)
 	if err != nil {
-		return nil, nil, err
+		return VideoID{}, err
 	}
 
-	req, err := c.NewRequest("GET", u, nil)
-	if err != nil {
-		return nil, nil, err
+	if ref.Kind != RefVideo {
+		return VideoID{}, fmt.Errorf("%q is not a video reference", s)
 	}
 
-	videos := &dataListVideo{}
-
-	resp, err := c.Do(req, videos)
+	ID, err := strconv.Atoi(ref.ID)
 	if err != nil {
-		return nil, resp, err
+		return VideoID{}, err
 	}
 
-	resp.setPaging(videos)
-
-	return videos.Data, resp, err
+	return VideoID{ID: ID, Hash: ref.Hash}, nil
 }
 
-func getVideo(c *Client, url string, opt ...CallOption) (*Video, *Response, error) {
-	u, err := addOptions(url, opt...)
-	if err != nil {
-		return nil, nil, err
+// String returns the identifier in the "123" or "123:abcdef" form.
+func (id VideoID) String() string {
+	if id.Hash != "" {
+		return fmt.Sprintf("%d:%s", id.ID, id.Hash)
 	}
+	return strconv.Itoa(id.ID)
+}
 
-	req, err := c.NewRequest("GET", u, nil)
-	if err != nil {
-		return nil, nil, err
+// GetVideoID returns the identifier of the video, including the unlisted hash
+// found in the URI or, for the owner of the video, in the link.
+func (v Video) GetVideoID() VideoID {
+	id := VideoID{ID: v.GetID()}
+
+	for _, s := range []string{v.URI, v.Link} {
+		if parsed, err := ParseVideoID(s); err == nil && parsed.Hash != "" {
+			id.Hash = parsed.Hash
+			break
+		}
 	}
 
-	video := &Video{}
+	return id
+}
 
-	resp, err := c.Do(req, video)
-	if err != nil {
-		return nil, resp, err
-	}
+// path returns the API path of the video, "videos/{id}:{hash}" for an unlisted video.
+func (id VideoID) path() string {
+	return "videos/" + id.String()
+}
 
-	return video, resp, err
+// UploadVideoRequest specifies the optional parameters to the
+// uploadVideo method.
+type UploadVideoRequest struct {
+	Name   string  `json:"name"`
+	Upload *Upload `json:"upload,omitempty"`
+}
+
+func listVideo(c *Client, url string, opt ...CallOption) ([]*Video, *Response, error) {
+	return List[Video](c, url, opt...)
+}
+
+func getVideo(c *Client, url string, opt ...CallOption) (*Video, *Response, error) {
+	return Get[Video](c, url, opt...)
 }
 
 func getUploadVideo(c *Client, method string, uri string, reqUpload *UploadVideoRequest) (*Video, *Response, error) { // nolint: unparam
@@ -376,28 +538,11 @@ func uploadVideoByURL(c *Client, uri, videoURL string) (*Video, *Response, error
 }
 
 func deleteVideo(c *Client, url string) (*Response, error) {
-	req, err := c.NewRequest("DELETE", url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return c.Do(req, nil)
+	return Delete(c, url)
 }
 
 func addVideo(c *Client, url string) (*Video, *Response, error) {
-	req, err := c.NewRequest("PUT", url, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	video := &Video{}
-
-	resp, err := c.Do(req, video)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	return video, resp, err
+	return send[Video](c, "PUT", url, nil)
 }
 
 // List method returns all the videos that match custom search criteria.
@@ -418,9 +563,8 @@ func (s *VideosService) MyList(opt ...CallOption) ([]*Video, *Response, error) {
 // Get method returns a single video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video
-func (s *VideosService) Get(vid int, opt ...CallOption) (*Video, *Response, error) {
-	u := fmt.Sprintf("videos/%d", vid)
-	video, resp, err := getVideo(s.client, u, opt...)
+func (s *VideosService) Get(vid VideoID, opt ...CallOption) (*Video, *Response, error) {
+	video, resp, err := getVideo(s.client, vid.path(), opt...)
 
 	return video, resp, err
 }
@@ -428,8 +572,16 @@ func (s *VideosService) Get(vid int, opt ...CallOption) (*Video, *Response, erro
 // Edit method edits the specified video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#edit_video
-func (s *VideosService) Edit(vid int, r *VideoRequest) (*Video, *Response, error) {
-	u := fmt.Sprintf("videos/%d", vid)
+func (s *VideosService) Edit(vid VideoID, r *VideoRequest, opt ...CallOption) (*Video, *Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	u, err := addOptions(vid.path(), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest("PATCH", u, r)
 	if err != nil {
 		return nil, nil, err
@@ -477,8 +629,12 @@ func (s *VideosService) LikeList(vid int, opt ...CallOption) ([]*User, *Response
 // GetPreset method determines whether the specified video uses a particular embed preset.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#get_video_embed_preset
-func (s *VideosService) GetPreset(vid int, p int) (*Preset, *Response, error) {
-	u := fmt.Sprintf("videos/%d/presets/%d", vid, p)
+func (s *VideosService) GetPreset(vid VideoID, p int, opt ...CallOption) (*Preset, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("%s/presets/%d", vid.path(), p), opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -497,8 +653,8 @@ func (s *VideosService) GetPreset(vid int, p int) (*Preset, *Response, error) {
 // AssignPreset method assigns an embed preset to the specified video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#add_video_embed_preset
-func (s *VideosService) AssignPreset(vid int, p int) (*Response, error) {
-	u := fmt.Sprintf("videos/%d/presets/%d", vid, p)
+func (s *VideosService) AssignPreset(vid VideoID, p int, opt ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("%s/presets/%d", vid.path(), p)
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
@@ -510,8 +666,8 @@ func (s *VideosService) AssignPreset(vid int, p int) (*Response, error) {
 // UnassignPreset method removes the embed preset from the specified video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#delete_video_embed_preset
-func (s *VideosService) UnassignPreset(vid int, p int) (*Response, error) {
-	u := fmt.Sprintf("videos/%d/presets/%d", vid, p)
+func (s *VideosService) UnassignPreset(vid VideoID, p int, opt ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("%s/presets/%d", vid.path(), p)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -534,8 +690,8 @@ type Domain struct {
 // ListDomain method returns all the domains on the specified video's whitelist.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video_privacy_domains
-func (s *VideosService) ListDomain(vid int, opt ...CallOption) ([]*Domain, *Response, error) {
-	u, err := addOptions(fmt.Sprintf("videos/%d/privacy/domains", vid), opt...)
+func (s *VideosService) ListDomain(vid VideoID, opt ...CallOption) ([]*Domain, *Response, error) {
+	u, err := addOptions(vid.path()+"/privacy/domains", opt...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -560,8 +716,8 @@ func (s *VideosService) ListDomain(vid int, opt ...CallOption) ([]*Domain, *Resp
 // AllowDomain method adds the specified domain to a video's whitelist.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#add_video_privacy_domain
-func (s *VideosService) AllowDomain(vid int, d string) (*Response, error) {
-	u := fmt.Sprintf("videos/%d/privacy/domains/%s", vid, d)
+func (s *VideosService) AllowDomain(vid VideoID, d string, opt ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("%s/privacy/domains/%s", vid.path(), d)
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
@@ -573,8 +729,8 @@ func (s *VideosService) AllowDomain(vid int, d string) (*Response, error) {
 // DisallowDomain method removes the specified domain from a video's whitelist.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#delete_video_privacy_domain
-func (s *VideosService) DisallowDomain(vid int, d string) (*Response, error) {
-	u := fmt.Sprintf("videos/%d/privacy/domains/%s", vid, d)
+func (s *VideosService) DisallowDomain(vid VideoID, d string, opt ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("%s/privacy/domains/%s", vid.path(), d)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -594,16 +750,28 @@ func (s *VideosService) ListUser(vid int, opt ...CallOption) ([]*User, *Response
 }
 
 // AllowUsers method gives multiple users permission to view the specified private video.
+// The users are IDs, URIs or links.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#add_video_privacy_users
-func (s *VideosService) AllowUsers(vid int) (*Response, error) {
+func (s *VideosService) AllowUsers(vid int, users []string) ([]*User, *Response, error) {
+	body, err := userURIs(users)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("videos/%d/privacy/users", vid)
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequest("PUT", u, body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return s.client.Do(req, nil)
+	var allowed []*User
+	resp, err := s.client.Do(req, &allowed)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return allowed, resp, nil
 }
 
 // AllowUser method gives a single user permission to view the specified private video.
@@ -635,8 +803,8 @@ func (s *VideosService) DisallowUser(vid int, uid string) (*Response, error) {
 // ListTag method returns all the tags associated with a video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video_tags
-func (s *VideosService) ListTag(vid int, opt ...CallOption) ([]*Tag, *Response, error) {
-	u := fmt.Sprintf("videos/%d/tags", vid)
+func (s *VideosService) ListTag(vid VideoID, opt ...CallOption) ([]*Tag, *Response, error) {
+	u := vid.path() + "/tags"
 	tags, resp, err := listTag(s.client, u, opt...)
 
 	return tags, resp, err
@@ -645,8 +813,8 @@ func (s *VideosService) ListTag(vid int, opt ...CallOption) ([]*Tag, *Response,
 // GetTag method determines whether a particular tag has been added to a video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#check_video_for_tag
-func (s *VideosService) GetTag(vid int, t string, opt ...CallOption) (*Tag, *Response, error) {
-	u := fmt.Sprintf("videos/%d/tags/%s", vid, t)
+func (s *VideosService) GetTag(vid VideoID, t string, opt ...CallOption) (*Tag, *Response, error) {
+	u := fmt.Sprintf("%s/tags/%s", vid.path(), t)
 	tag, resp, err := getTag(s.client, u, opt...)
 
 	return tag, resp, err
@@ -655,8 +823,8 @@ func (s *VideosService) GetTag(vid int, t string, opt ...CallOption) (*Tag, *Res
 // AssignTag method adds a single tag to the specified video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#add_video_tag
-func (s *VideosService) AssignTag(vid int, t string) (*Response, error) {
-	u := fmt.Sprintf("videos/%d/tags/%s", vid, t)
+func (s *VideosService) AssignTag(vid VideoID, t string, opt ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("%s/tags/%s", vid.path(), t)
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
@@ -668,8 +836,8 @@ func (s *VideosService) AssignTag(vid int, t string) (*Response, error) {
 // UnassignTag method removes the specified tag from a video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#delete_video_tag
-func (s *VideosService) UnassignTag(vid int, t string) (*Response, error) {
-	u := fmt.Sprintf("videos/%d/tags/%s", vid, t)
+func (s *VideosService) UnassignTag(vid VideoID, t string, opt ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("%s/tags/%s", vid.path(), t)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,162 @@
+package vimeo
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// maxBatchSize is the largest number of videos a single request to the /videos endpoint returns.
+const maxBatchSize = 100
+
+// BatchResult represents the result of VideosService.GetMany.
+type BatchResult struct {
+	// Videos is in the order of the requested IDs. A missing or forbidden video is nil.
+	Videos []*Video
+	// Missing lists the IDs the API didn't return.
+	Missing []int
+	// Forbidden lists the IDs the API refused to return.
+	Forbidden []int
+}
+
+// OptURIs is an optional argument to an API call. Filters a list by resource URIs.
+type OptURIs []string
+
+// Get key/value for make query
+func (o OptURIs) Get() (string, string) {
+	return "uris", strings.Join(o, ",")
+}
+
+type batchResult struct {
+	videos    []*Video
+	forbidden []int
+	err       error
+}
+
+// GetMany method returns many videos using the uris filter of the /videos endpoint.
+// The IDs are split into batches that are requested concurrently, up to Config.Concurrency at a time.
+// The paging options are set by the batches, and OptFields always includes "uri" to match the videos to the IDs.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/videos#search_videos
+func (s *VideosService) GetMany(ids []int, opt ...CallOption) (*BatchResult, error) {
+	var batches [][]int
+	for start := 0; start < len(ids); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batches = append(batches, ids[start:end])
+	}
+
+	results := make([]batchResult, len(batches))
+	sem := make(chan struct{}, s.client.Config.concurrency())
+
+	var wg sync.WaitGroup
+	for i, batch := range batches {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, batch []int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i] = s.getBatch(batch, opt...)
+		}(i, batch)
+	}
+	wg.Wait()
+
+	found := make(map[int]*Video, len(ids))
+	forbidden := make(map[int]bool)
+	for _, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+
+		for _, video := range r.videos {
+			found[video.GetID()] = video
+		}
+
+		for _, id := range r.forbidden {
+			forbidden[id] = true
+		}
+	}
+
+	result := &BatchResult{Videos: make([]*Video, len(ids))}
+	for i, id := range ids {
+		video, ok := found[id]
+		switch {
+		case ok:
+			result.Videos[i] = video
+		case forbidden[id]:
+			result.Forbidden = append(result.Forbidden, id)
+		default:
+			result.Missing = append(result.Missing, id)
+		}
+	}
+
+	return result, nil
+}
+
+// getBatch requests the videos of a batch. The API refuses the whole batch when one of
+// its videos is forbidden, so a refused batch is split in halves until the forbidden IDs are found.
+func (s *VideosService) getBatch(ids []int, opt ...CallOption) batchResult {
+	uris := make(OptURIs, len(ids))
+	for i, id := range ids {
+		uris[i] = "/videos/" + strconv.Itoa(id)
+	}
+
+	videos, _, err := listVideo(s.client, "videos", batchOptions(uris, opt)...)
+	if err != nil {
+		errResp, ok := err.(*ErrorResponse)
+		if !ok || errResp.Response.StatusCode != http.StatusForbidden {
+			return batchResult{err: err}
+		}
+		if len(ids) == 1 {
+			return batchResult{forbidden: ids}
+		}
+
+		half := len(ids) / 2
+		left := s.getBatch(ids[:half], opt...)
+		if left.err != nil {
+			return left
+		}
+		right := s.getBatch(ids[half:], opt...)
+		if right.err != nil {
+			return right
+		}
+		return batchResult{
+			videos:    append(left.videos, right.videos...),
+			forbidden: append(left.forbidden, right.forbidden...),
+		}
+	}
+
+	return batchResult{videos: videos}
+}
+
+// batchOptions returns the options of a batch request: the caller options without
+// paging and uris, with "uri" added to the fields, then the uris and per_page of the batch.
+func batchOptions(uris OptURIs, opt []CallOption) []CallOption {
+	batchOpt := make([]CallOption, 0, len(opt)+2)
+	for _, o := range opt {
+		switch k, v := o.Get(); k {
+		case "page", "per_page", "uris":
+			continue
+		case "fields":
+			fields := strings.Split(v, ",")
+			if !containsString(fields, "uri") {
+				o = OptFields(append(fields, "uri"))
+			}
+		}
+		batchOpt = append(batchOpt, o)
+	}
+	return append(batchOpt, uris, OptPerPage(len(uris)))
+}
+
+func containsString(s []string, v string) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -19,7 +19,7 @@ type Comment struct {
 
 // CommentRequest represents a request to create/edit an comment.
 type CommentRequest struct {
-	Text string `json:"text,omitempty"`
+	Text *string `json:"text,omitempty"`
 }
 
 // ListComment method returns all the comments on the specified video.
@@ -17,11 +17,12 @@ type Credit struct {
 }
 
 // CreditRequest represents a request to create/edit an creadit.
+// Nil fields are left out of the request.
 type CreditRequest struct {
-	Role    string `json:"role,omitempty"`
-	Name    string `json:"name,omitempty"`
-	Email   string `json:"email,omitempty"`
-	UserURI string `json:"user_uri,omitempty"`
+	Role    *string `json:"role,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	Email   *string `json:"email,omitempty"`
+	UserURI *string `json:"user_uri,omitempty"`
 }
 
 // ListCredit method returns all the credited users in a video.
@@ -0,0 +1,407 @@
+package vimeo
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// VideoState represents the desired state of a video.
+// Nil fields are not managed and never produce a change.
+type VideoState struct {
+	Name          *string
+	Description   *string
+	License       *License
+	Password      *string
+	Locale        *string
+	Privacy       *PrivacyRequest
+	ContentRating []ContentRatingCode
+	Embed         *EmbedRequest
+	ReviewPage    *bool
+	// Tags is the complete list of tags the video must have.
+	Tags []string
+	// Preset is the embed preset the video must use, zero means no preset.
+	Preset *int
+	// Domains is the complete list of domains the video can be embedded on.
+	Domains []string
+}
+
+// VideoDiff represents the changes that bring a video to its desired state.
+type VideoDiff struct {
+	// Request is the minimal request for VideosService.Edit, nil if no field changed.
+	Request *VideoRequest
+	// Fields lists the changed fields, in dotted JSON notation ("embed.color").
+	Fields []string
+
+	AddTags         []string
+	RemoveTags      []string
+	AssignPreset    int
+	UnassignPreset  int
+	AllowDomains    []string
+	DisallowDomains []string
+}
+
+// Empty reports whether the diff holds no change.
+func (d *VideoDiff) Empty() bool {
+	return d.Request == nil && len(d.AddTags) == 0 && len(d.RemoveTags) == 0 &&
+		d.AssignPreset == 0 && d.UnassignPreset == 0 &&
+		len(d.AllowDomains) == 0 && len(d.DisallowDomains) == 0
+}
+
+// DiffVideo compares the current video against the desired state and returns the changes.
+// The domains a video can be embedded on are not part of Video, so the current list is
+// passed in domains; it is ignored when desired.Domains is nil.
+//
+// Embed settings the API doesn't return with a video (autoplay, loop, badge and the like)
+// can't be compared, so they are always part of the request when set.
+// A nil desired state is an empty diff, a nil current video is compared as an empty one.
+func DiffVideo(current *Video, domains []*Domain, desired *VideoState) *VideoDiff {
+	d := &VideoDiff{}
+	if desired == nil {
+		return d
+	}
+	if current == nil {
+		current = &Video{}
+	}
+	r := &VideoRequest{}
+
+	r.Name = diffField(d, "name", current.Name, desired.Name)
+	r.Description = diffField(d, "description", current.Description, desired.Description)
+	r.License = diffField(d, "license", current.License, desired.License)
+	r.Password = diffField(d, "password", current.Password, desired.Password)
+	r.Locale = diffField(d, "locale", current.Language, desired.Locale)
+
+	if desired.ContentRating != nil && !sameSet(current.ContentRating, desired.ContentRating) {
+		r.ContentRating = desired.ContentRating
+		d.Fields = append(d.Fields, "content_rating")
+	}
+
+	if desired.Privacy != nil {
+		r.Privacy = d.diffPrivacy(current.Privacy, desired.Privacy)
+	}
+
+	if desired.Embed != nil {
+		r.Embed = d.diffEmbed(current.Embed, desired.Embed)
+	}
+
+	if desired.ReviewPage != nil {
+		active := current.ReviewPage != nil && current.ReviewPage.Active
+		if active != *desired.ReviewPage {
+			r.ReviewPage = &ReviewPageRequest{Active: Bool(*desired.ReviewPage)}
+			d.Fields = append(d.Fields, "review_page.active")
+		}
+	}
+
+	if len(d.Fields) > 0 {
+		d.Request = r
+	}
+
+	if desired.Tags != nil {
+		have := make([]string, 0, len(current.Tags))
+		for _, t := range current.Tags {
+			if t.Tag != "" {
+				have = append(have, t.Tag)
+			} else {
+				have = append(have, t.Name)
+			}
+		}
+		d.AddTags, d.RemoveTags = diffStrings(have, desired.Tags)
+	}
+
+	if desired.Preset != nil {
+		have := 0
+		if current.EmbedPresets != nil {
+			have = lastPathID(current.EmbedPresets.URI)
+		}
+		if have != *desired.Preset {
+			d.UnassignPreset = have
+			d.AssignPreset = *desired.Preset
+		}
+	}
+
+	if desired.Domains != nil {
+		have := make([]string, 0, len(domains))
+		for _, dm := range domains {
+			have = append(have, dm.Name)
+		}
+		d.AllowDomains, d.DisallowDomains = diffStrings(have, desired.Domains)
+	}
+
+	return d
+}
+
+// diffField returns desired, and records the field, when it is set and differs from current.
+func diffField[T comparable](d *VideoDiff, field string, current T, desired *T) *T {
+	if desired == nil || *desired == current {
+		return nil
+	}
+	d.Fields = append(d.Fields, field)
+	return desired
+}
+
+func (d *VideoDiff) diffPrivacy(current *VideoPrivacy, desired *PrivacyRequest) *PrivacyRequest {
+	if current == nil {
+		current = &VideoPrivacy{}
+	}
+
+	p := &PrivacyRequest{
+		View:     diffField(d, "privacy.view", current.View, desired.View),
+		Embed:    diffField(d, "privacy.embed", current.Embed, desired.Embed),
+		Comment:  diffField(d, "privacy.comment", current.Comment, desired.Comment),
+		Download: diffField(d, "privacy.download", current.Download, desired.Download),
+		Add:      diffField(d, "privacy.add", current.Add, desired.Add),
+	}
+	if *p == (PrivacyRequest{}) {
+		return nil
+	}
+	return p
+}
+
+func (d *VideoDiff) diffEmbed(current *Embed, desired *EmbedRequest) *EmbedRequest {
+	if current == nil {
+		current = &Embed{}
+	}
+
+	// Start from the settings that can't be compared and drop the ones that can.
+	e := *desired
+	e.Buttons, e.Logos, e.Title, e.Color, e.PlayBar, e.Volume = nil, nil, nil, nil, nil, nil
+
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"embed.outro", e.Outro != nil},
+		{"embed.portrait", e.Portrait != nil},
+		{"embed.byline", e.ByLine != nil},
+		{"embed.badge", e.Badge != nil},
+		{"embed.byline_badge", e.ByLineBadge != nil},
+		{"embed.collections_button", e.CollectionsButton != nil},
+		{"embed.fullscreen_button", e.FullscreenButton != nil},
+		{"embed.scaling_button", e.ScalingButton != nil},
+		{"embed.autoplay", e.Autoplay != nil},
+		{"embed.autopause", e.Autopause != nil},
+		{"embed.loop", e.Loop != nil},
+		{"embed.link", e.Link != nil},
+		{"embed.ratings", e.RatingsRequest != nil},
+		{"embed.external_links", e.ExtraLinks != nil},
+		{"embed.overlay_email_capture", e.OverlayEmailCapture != nil},
+		{"embed.overlay_email_capture_text", e.OverlayEmailCaptureText != nil},
+		{"embed.overlay_email_capture_confirmation", e.OverlayEmailCaptureConfirmation != nil},
+	} {
+		if f.set {
+			d.Fields = append(d.Fields, f.name)
+		}
+	}
+
+	if desired.Color != nil && normalizeColor(*desired.Color) != normalizeColor(current.Color) {
+		e.Color = desired.Color
+		d.Fields = append(d.Fields, "embed.color")
+	}
+	e.PlayBar = diffField(d, "embed.playbar", current.PlayBar, desired.PlayBar)
+	e.Volume = diffField(d, "embed.volume", current.Volume, desired.Volume)
+
+	if desired.Buttons != nil {
+		have := current.Buttons
+		if have == nil {
+			have = &Buttons{}
+		}
+		b := &Buttons{
+			Like:       d.diffBoolPtr("embed.buttons.like", have.Like, desired.Buttons.Like),
+			WatchLater: d.diffBoolPtr("embed.buttons.watchlater", have.WatchLater, desired.Buttons.WatchLater),
+			Share:      d.diffBoolPtr("embed.buttons.share", have.Share, desired.Buttons.Share),
+			Embed:      d.diffBoolPtr("embed.buttons.embed", have.Embed, desired.Buttons.Embed),
+			Vote:       d.diffBoolPtr("embed.buttons.vote", have.Vote, desired.Buttons.Vote),
+			HD:         d.diffBoolPtr("embed.buttons.HD", have.HD, desired.Buttons.HD),
+			Fullscreen: d.diffBoolPtr("embed.buttons.fullscreen", have.Fullscreen, desired.Buttons.Fullscreen),
+			Scaling:    d.diffBoolPtr("embed.buttons.scaling", have.Scaling, desired.Buttons.Scaling),
+		}
+		if *b != (Buttons{}) {
+			e.Buttons = b
+		}
+	}
+
+	if desired.Logos != nil {
+		have := current.Logos
+		if have == nil {
+			have = &EmbedLogos{}
+		}
+		custom := have.Custom
+		if custom == nil {
+			custom = &EmbedCustomLogo{}
+		}
+		l := &Logos{
+			Vimeo:        diffField(d, "embed.logos.vimeo", have.Vimeo, desired.Logos.Vimeo),
+			Custom:       diffField(d, "embed.logos.custom", custom.Active, desired.Logos.Custom),
+			StickyCustom: diffField(d, "embed.logos.sticky_custom", custom.Sticky, desired.Logos.StickyCustom),
+		}
+		if *l != (Logos{}) {
+			e.Logos = l
+		}
+	}
+
+	if desired.Title != nil {
+		have := current.Title
+		if have == nil {
+			have = &EmbedTitle{}
+		}
+		t := &TitleRequest{
+			Name:     diffField(d, "embed.title.name", have.Name, desired.Title.Name),
+			Owner:    diffField(d, "embed.title.owner", have.Owner, desired.Title.Owner),
+			Portrait: diffField(d, "embed.title.portrait", have.Portrait, desired.Title.Portrait),
+		}
+		if *t != (TitleRequest{}) {
+			e.Title = t
+		}
+	}
+
+	if e == (EmbedRequest{}) {
+		return nil
+	}
+	return &e
+}
+
+func (d *VideoDiff) diffBoolPtr(field string, current, desired *bool) *bool {
+	if desired == nil || (current != nil && *current == *desired) {
+		return nil
+	}
+	d.Fields = append(d.Fields, field)
+	return desired
+}
+
+func normalizeColor(c string) string {
+	return strings.ToLower(strings.TrimPrefix(c, "#"))
+}
+
+// diffStrings returns the values of desired missing from current and the values
+// of current missing from desired. The comparison ignores case.
+func diffStrings(current, desired []string) (add, remove []string) {
+	have := make(map[string]bool, len(current))
+	for _, s := range current {
+		have[strings.ToLower(s)] = true
+	}
+
+	want := make(map[string]bool, len(desired))
+	for _, s := range desired {
+		k := strings.ToLower(s)
+		if !have[k] && !want[k] {
+			add = append(add, s)
+		}
+		want[k] = true
+	}
+
+	for _, s := range current {
+		if !want[strings.ToLower(s)] {
+			remove = append(remove, s)
+		}
+	}
+
+	return add, remove
+}
+
+func sameSet[T ~string](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x := append([]T(nil), a...)
+	y := append([]T(nil), b...)
+	sort.Slice(x, func(i, j int) bool { return x[i] < x[j] })
+	sort.Slice(y, func(i, j int) bool { return y[i] < y[j] })
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func lastPathID(uri string) int {
+	l := strings.Split(strings.TrimSuffix(uri, "/"), "/")
+	id, _ := strconv.Atoi(l[len(l)-1])
+	return id
+}
+
+// Apply method brings the video to the desired state with the fewest requests:
+// a single PATCH for the changed fields, followed by the tag, preset and domain changes.
+// It returns the applied diff; on error the diff holds every change, including the ones not applied yet.
+// The unlisted hash of the current video is used for every request.
+func (s *VideosService) Apply(current *Video, desired *VideoState) (*VideoDiff, error) {
+	if current == nil || desired == nil {
+		return nil, errors.New("apply needs a current video and a desired state")
+	}
+
+	id := current.GetVideoID()
+	if id.ID == 0 {
+		return nil, fmt.Errorf("video %q has no ID", current.URI)
+	}
+
+	var domains []*Domain
+	if desired.Domains != nil {
+		var err error
+		domains, err = s.listAllDomains(id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	d := DiffVideo(current, domains, desired)
+
+	if d.Request != nil {
+		if _, _, err := s.Edit(id, d.Request); err != nil {
+			return d, err
+		}
+	}
+
+	for _, t := range d.RemoveTags {
+		if _, err := s.UnassignTag(id, t); err != nil {
+			return d, err
+		}
+	}
+
+	for _, t := range d.AddTags {
+		if _, err := s.AssignTag(id, t); err != nil {
+			return d, err
+		}
+	}
+
+	if d.UnassignPreset != 0 {
+		if _, err := s.UnassignPreset(id, d.UnassignPreset); err != nil {
+			return d, err
+		}
+	}
+
+	if d.AssignPreset != 0 {
+		if _, err := s.AssignPreset(id, d.AssignPreset); err != nil {
+			return d, err
+		}
+	}
+
+	for _, dm := range d.DisallowDomains {
+		if _, err := s.DisallowDomain(id, dm); err != nil {
+			return d, err
+		}
+	}
+
+	for _, dm := range d.AllowDomains {
+		if _, err := s.AllowDomain(id, dm); err != nil {
+			return d, err
+		}
+	}
+
+	return d, nil
+}
+
+func (s *VideosService) listAllDomains(vid VideoID) ([]*Domain, error) {
+	var all []*Domain
+	for page := 1; ; page++ {
+		domains, resp, err := s.ListDomain(vid, OptPage(page), OptPerPage(maxBatchSize))
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, domains...)
+		if resp.NextPage == "" {
+			return all, nil
+		}
+	}
+}
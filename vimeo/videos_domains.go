@@ -0,0 +1,78 @@
+package vimeo
+
+import "fmt"
+
+// DomainSync describes the embed domains every video of a scope should allow.
+// The scope is Videos, and every video returned by List, for example:
+//
+//	List: client.Users.FolderScope("/users/1/projects/2")
+//	List: client.Users.AlbumScope("", "123")
+//	List: client.Users.LibraryScope("")
+//
+// The domains only apply to videos whose embed privacy is "whitelist".
+type DomainSync struct {
+	Domains []string
+	Videos  []int
+	List    ListFunc[Video]
+	// DryRun computes the changes without sending them.
+	DryRun bool
+	// Concurrency limits the number of videos synced at the same time, Config.Concurrency when zero.
+	Concurrency int
+}
+
+// SyncDomains method brings the embed domains of every video in the scope to the desired set.
+// Videos are synced concurrently; a failed video is reported in its SyncChange and doesn't stop the others.
+// The returned error is the one of listing the scope.
+func (s *VideosService) SyncDomains(ds *DomainSync) (*SyncReport, error) {
+	ids, err := scopeIDs(ds.Videos, ds.List)
+	if err != nil {
+		return nil, err
+	}
+
+	n := ds.Concurrency
+	if n < 1 {
+		n = s.client.Config.concurrency()
+	}
+
+	report := syncVideos(ids, n, ds.DryRun, func(vid int) *SyncChange {
+		return s.syncVideoDomains(vid, ds.Domains, ds.DryRun)
+	})
+
+	return report, nil
+}
+
+func (s *VideosService) syncVideoDomains(vid int, desired []string, dryRun bool) *SyncChange {
+	c := &SyncChange{VideoID: vid}
+	id := VideoID{ID: vid}
+
+	domains, err := s.listAllDomains(id)
+	if err != nil {
+		c.Err = err
+		return c
+	}
+
+	current := make([]string, len(domains))
+	for i, d := range domains {
+		current[i] = d.Name
+	}
+	c.Add, c.Remove = diffStrings(current, desired)
+
+	if dryRun {
+		return c
+	}
+
+	for _, d := range c.Add {
+		if _, err := s.AllowDomain(id, d); err != nil {
+			c.Err = fmt.Errorf("allow %s: %w", d, err)
+			return c
+		}
+	}
+	for _, d := range c.Remove {
+		if _, err := s.DisallowDomain(id, d); err != nil {
+			c.Err = fmt.Errorf("disallow %s: %w", d, err)
+			return c
+		}
+	}
+
+	return c
+}
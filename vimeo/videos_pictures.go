@@ -42,9 +42,10 @@ type Header struct {
 }
 
 // PicturesRequest represents a request to create/edit an pictures.
+// Nil fields are left out of the request.
 type PicturesRequest struct {
-	Time   float32 `json:"time,omitempty"`
-	Active bool    `json:"active,omitempty"`
+	Time   *float32 `json:"time,omitempty"`
+	Active *bool    `json:"active,omitempty"`
 }
 
 // GetID returns the numeric identifier (ID) of the video.
@@ -57,8 +58,8 @@ func (v Pictures) GetID() int {
 // ListPictures method returns all the thumbnail images of the specified video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video_thumbnails
-func (s *VideosService) ListPictures(vid int, opt ...CallOption) ([]*Pictures, *Response, error) {
-	u, err := addOptions(fmt.Sprintf("videos/%d/pictures", vid), opt...)
+func (s *VideosService) ListPictures(vid VideoID, opt ...CallOption) ([]*Pictures, *Response, error) {
+	u, err := addOptions(vid.path()+"/pictures", opt...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -83,8 +84,8 @@ func (s *VideosService) ListPictures(vid int, opt ...CallOption) ([]*Pictures, *
 // CreatePictures method adds a thumbnail image to the specified video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#create_video_thumbnail
-func (s *VideosService) CreatePictures(vid int, r *PicturesRequest) (*Pictures, *Response, error) {
-	u := fmt.Sprintf("videos/%d/pictures", vid)
+func (s *VideosService) CreatePictures(vid VideoID, r *PicturesRequest, opt ...CallOption) (*Pictures, *Response, error) {
+	u := vid.path() + "/pictures"
 	req, err := s.client.NewRequest("POST", u, r)
 	if err != nil {
 		return nil, nil, err
@@ -102,8 +103,8 @@ func (s *VideosService) CreatePictures(vid int, r *PicturesRequest) (*Pictures,
 // GetPictures method returns a single thumbnail image from the specified video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_video_thumbnail
-func (s *VideosService) GetPictures(vid int, pid int, opt ...CallOption) (*Pictures, *Response, error) {
-	u, err := addOptions(fmt.Sprintf("videos/%d/pictures/%d", vid, pid), opt...)
+func (s *VideosService) GetPictures(vid VideoID, pid int, opt ...CallOption) (*Pictures, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("%s/pictures/%d", vid.path(), pid), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -126,8 +127,8 @@ func (s *VideosService) GetPictures(vid int, pid int, opt ...CallOption) (*Pictu
 // EditPictures method edits the specified video thumbnail image.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#edit_video_thumbnail
-func (s *VideosService) EditPictures(vid int, pid int, r *PicturesRequest) (*Pictures, *Response, error) {
-	u := fmt.Sprintf("videos/%d/pictures/%d", vid, pid)
+func (s *VideosService) EditPictures(vid VideoID, pid int, r *PicturesRequest, opt ...CallOption) (*Pictures, *Response, error) {
+	u := fmt.Sprintf("%s/pictures/%d", vid.path(), pid)
 	req, err := s.client.NewRequest("PATCH", u, r)
 	if err != nil {
 		return nil, nil, err
@@ -145,8 +146,8 @@ func (s *VideosService) EditPictures(vid int, pid int, r *PicturesRequest) (*Pic
 // DeletePictures method deletes the specified thumbnail image from a video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#delete_video_thumbnail
-func (s *VideosService) DeletePictures(vid int, pid int) (*Response, error) {
-	u := fmt.Sprintf("videos/%d/pictures/%d", vid, pid)
+func (s *VideosService) DeletePictures(vid VideoID, pid int, opt ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("%s/pictures/%d", vid.path(), pid)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -156,35 +157,43 @@ func (s *VideosService) DeletePictures(vid int, pid int) (*Response, error) {
 }
 
 // UploadPicture shortcut upload picture file.
-func (s *VideosService) UploadPicture(vid int, r *PicturesRequest, file *os.File) (*Pictures, *Response, error) {
-	pictures, _, err := s.CreatePictures(vid, r)
+func (s *VideosService) UploadPicture(vid VideoID, r *PicturesRequest, file *os.File, opt ...CallOption) (*Pictures, *Response, error) {
+	pictures, err := uploadPicture(s.client, file, func() (*Pictures, error) {
+		p, _, err := s.CreatePictures(vid, r, opt...)
+		return p, err
+	})
 	if err != nil {
 		return nil, nil, err
 	}
 
+	return s.GetPictures(vid, pictures.GetID(), opt...)
+}
+
+// uploadPicture checks that file isn't a directory, creates a picture, logo or thumbnail
+// with create and uploads the file to its upload link.
+func uploadPicture(c *Client, file *os.File, create func() (*Pictures, error)) (*Pictures, error) {
 	stat, err := file.Stat()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	if stat.IsDir() {
-		return nil, nil, errors.New("the video file can't be a directory")
+		return nil, errors.New("the picture file can't be a directory")
 	}
 
-	req, err := http.NewRequest("PUT", pictures.Link, file)
+	pictures, err := create()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	_, err = s.client.Do(req, nil)
+	req, err := http.NewRequest("PUT", pictures.Link, file)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	pictures, resp, err := s.GetPictures(vid, pictures.GetID())
-	if err != nil {
-		return nil, nil, err
+	if _, err := c.Do(req, nil); err != nil {
+		return nil, err
 	}
 
-	return pictures, resp, err
+	return pictures, nil
 }
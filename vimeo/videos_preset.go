@@ -1,6 +1,11 @@
 package vimeo
 
-import "fmt"
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
 
 type dataListPreset struct {
 	Data []*Preset `json:"data,omitempty"`
@@ -9,8 +14,49 @@ type dataListPreset struct {
 
 // Preset represents a preset.
 type Preset struct {
-	URI  string `json:"uri,omitempty"`
-	Name string `json:"name,omitempty"`
+	URI      string         `json:"uri,omitempty"`
+	Name     string         `json:"name,omitempty"`
+	Settings *EmbedSettings `json:"settings,omitempty"`
+	User     *User          `json:"user,omitempty"`
+	Metadata *Metadata      `json:"metadata,omitempty"`
+}
+
+// PresetRequest represents a request to create/edit an embed preset.
+// Nil fields are left out of the request.
+type PresetRequest struct {
+	Name     *string            `json:"name,omitempty"`
+	Settings *EmbedSettings     `json:"settings,omitempty"`
+	Logo     *PresetLogoRequest `json:"custom_logo,omitempty"`
+}
+
+// PresetLogoRequest represents the custom logo of an embed preset.
+// Nil fields are left out of the request.
+type PresetLogoRequest struct {
+	ID     *int    `json:"id,omitempty"`
+	Active *bool   `json:"active,omitempty"`
+	Link   *string `json:"link,omitempty"`
+	Sticky *bool   `json:"sticky,omitempty"`
+}
+
+// GetID returns the numeric identifier (ID) of the preset.
+func (p Preset) GetID() int {
+	l := strings.SplitN(p.URI, "/", -1)
+	ID, _ := strconv.Atoi(l[len(l)-1])
+	return ID
+}
+
+func presetsPath(uid string) string {
+	if uid == "" {
+		return "me/presets"
+	}
+	return fmt.Sprintf("users/%s/presets", uid)
+}
+
+func customLogosPath(uid string) string {
+	if uid == "" {
+		return "me/customlogos"
+	}
+	return fmt.Sprintf("users/%s/customlogos", uid)
 }
 
 // ListPreset method returns all the embed presets that belong to the specified user.
@@ -77,9 +123,9 @@ func (s *UsersService) GetPreset(uid string, p int, opt ...CallOption) (*Preset,
 	return portf, resp, err
 }
 
-// PresetListVideo method edits an embed present belonging to the specified user.
+// PresetListVideo method returns all the videos that use the specified embed preset.
 //
-// Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#edit_embed_preset
+// Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#get_embed_preset_videos
 func (s *UsersService) PresetListVideo(uid string, p int, opt ...CallOption) ([]*Video, *Response, error) {
 	var u string
 	if uid == "" {
@@ -92,3 +138,68 @@ func (s *UsersService) PresetListVideo(uid string, p int, opt ...CallOption) ([]
 
 	return videos, resp, err
 }
+
+// CreatePreset method creates an embed preset for the specified user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#create_embed_preset
+func (s *UsersService) CreatePreset(uid string, r *PresetRequest) (*Preset, *Response, error) {
+	return Post[Preset](s.client, presetsPath(uid), r)
+}
+
+// EditPreset method edits an embed preset belonging to the specified user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#edit_embed_preset
+func (s *UsersService) EditPreset(uid string, p int, r *PresetRequest) (*Preset, *Response, error) {
+	return Patch[Preset](s.client, fmt.Sprintf("%s/%d", presetsPath(uid), p), r)
+}
+
+// DeletePreset method deletes an embed preset belonging to the specified user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#delete_embed_preset
+func (s *UsersService) DeletePreset(uid string, p int) (*Response, error) {
+	return Delete(s.client, fmt.Sprintf("%s/%d", presetsPath(uid), p))
+}
+
+// ListCustomLogo method returns all the custom logos of the specified user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#get_custom_logos
+func (s *UsersService) ListCustomLogo(uid string, opt ...CallOption) ([]*Pictures, *Response, error) {
+	return List[Pictures](s.client, customLogosPath(uid), opt...)
+}
+
+// CreateCustomLogo method adds a custom logo for the specified user. The returned Link is the upload link of the image.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#create_custom_logo
+func (s *UsersService) CreateCustomLogo(uid string) (*Pictures, *Response, error) {
+	return Post[Pictures](s.client, customLogosPath(uid), nil)
+}
+
+// DeleteCustomLogo method deletes a custom logo of the specified user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets#delete_custom_logo
+func (s *UsersService) DeleteCustomLogo(uid string, lid int) (*Response, error) {
+	return Delete(s.client, fmt.Sprintf("%s/%d", customLogosPath(uid), lid))
+}
+
+// UploadPresetLogo shortcut upload a custom logo file and make it the active logo of the embed preset.
+// Passing the empty string will edit authenticated user.
+func (s *UsersService) UploadPresetLogo(uid string, p int, file *os.File) (*Preset, *Response, error) {
+	logo, err := uploadPicture(s.client, file, func() (*Pictures, error) {
+		l, _, err := s.CreateCustomLogo(uid)
+		return l, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.EditPreset(uid, p, &PresetRequest{
+		Settings: &EmbedSettings{Logos: &Logos{Custom: Bool(true)}},
+		Logo:     &PresetLogoRequest{ID: Int(logo.GetID()), Active: Bool(true)},
+	})
+}
@@ -0,0 +1,145 @@
+package vimeo
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// SyncChange represents the values added to and removed from a video by a sync,
+// such as embed domains or viewers.
+type SyncChange struct {
+	VideoID int
+	Add     []string
+	Remove  []string
+	// Err is the error that stopped the sync of the video. Some of the changes may be applied.
+	Err error
+}
+
+// SyncReport represents the result of a sync over many videos.
+type SyncReport struct {
+	DryRun bool
+	// Videos is the number of videos in the scope.
+	Videos int
+	// Changes lists the videos that were changed, or would be in a dry run, and the failed ones,
+	// in the order of the scope.
+	Changes []*SyncChange
+	Added   int
+	Removed int
+	Failed  int
+}
+
+// String returns a one line summary of the report.
+func (r *SyncReport) String() string {
+	s := fmt.Sprintf("%d videos, %d changed, %d added, %d removed, %d failed",
+		r.Videos, len(r.Changes)-r.Failed, r.Added, r.Removed, r.Failed)
+	if r.DryRun {
+		s += " (dry run)"
+	}
+	return s
+}
+
+// WriteTo writes the changes of every video followed by the summary to w.
+func (r *SyncReport) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+	for _, c := range r.Changes {
+		fmt.Fprintf(&b, "/videos/%d\n", c.VideoID)
+		for _, v := range c.Add {
+			fmt.Fprintf(&b, "  + %s\n", v)
+		}
+		for _, v := range c.Remove {
+			fmt.Fprintf(&b, "  - %s\n", v)
+		}
+		if c.Err != nil {
+			fmt.Fprintf(&b, "  error: %v\n", c.Err)
+		}
+	}
+	b.WriteString(r.String() + "\n")
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// FolderScope returns the videos of a folder as a scope for DomainSync.List or ViewerSync.List.
+func (s *UsersService) FolderScope(folderURI string) ListFunc[Video] {
+	return func(opt ...CallOption) ([]*Video, *Response, error) {
+		return s.ListFolderVideos(folderURI, opt...)
+	}
+}
+
+// AlbumScope returns the videos of an album as a scope for DomainSync.List or ViewerSync.List.
+// Passing the empty string will use authenticated user.
+func (s *UsersService) AlbumScope(uid string, ab string) ListFunc[Video] {
+	return func(opt ...CallOption) ([]*Video, *Response, error) {
+		return s.AlbumListVideo(uid, ab, opt...)
+	}
+}
+
+// LibraryScope returns every video of a user as a scope for DomainSync.List or ViewerSync.List.
+// Passing the empty string will use authenticated user.
+func (s *UsersService) LibraryScope(uid string) ListFunc[Video] {
+	return func(opt ...CallOption) ([]*Video, *Response, error) {
+		return s.ListVideo(uid, opt...)
+	}
+}
+
+// scopeIDs returns the videos followed by the IDs of every video of the list,
+// without duplicates and in the order they were first seen.
+func scopeIDs(videos []int, list ListFunc[Video]) ([]int, error) {
+	all := append([]int(nil), videos...)
+	if list != nil {
+		listed, err := NewIterator(list, OptPerPage(maxBatchSize)).All()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range listed {
+			all = append(all, v.GetID())
+		}
+	}
+
+	seen := make(map[int]bool, len(all))
+	ids := all[:0]
+	for _, id := range all {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// syncVideos calls fn for every video, up to n at a time, and collects the changes into a report.
+func syncVideos(ids []int, n int, dryRun bool, fn func(vid int) *SyncChange) *SyncReport {
+	changes := make([]*SyncChange, len(ids))
+	sem := make(chan struct{}, n)
+
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i, id int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			changes[i] = fn(id)
+		}(i, id)
+	}
+	wg.Wait()
+
+	report := &SyncReport{DryRun: dryRun, Videos: len(ids)}
+	for _, c := range changes {
+		if len(c.Add) == 0 && len(c.Remove) == 0 && c.Err == nil {
+			continue
+		}
+		report.Changes = append(report.Changes, c)
+		if c.Err != nil {
+			report.Failed++
+			continue
+		}
+		report.Added += len(c.Add)
+		report.Removed += len(c.Remove)
+	}
+
+	return report
+}
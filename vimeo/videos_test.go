@@ -3,9 +3,15 @@ package vimeo
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestVideo_GetID(t *testing.T) {
@@ -16,6 +22,188 @@ func TestVideo_GetID(t *testing.T) {
 	}
 }
 
+func TestStats_unmarshal(t *testing.T) {
+	s := &Stats{}
+	if err := json.Unmarshal([]byte(`{"plays": 10, "likes": 2, "comments": 1}`), s); err != nil {
+		t.Fatalf("json.Unmarshal returned unexpected error: %v", err)
+	}
+
+	want := &Stats{Plays: 10, Likes: 2, Comments: 1}
+	if !reflect.DeepEqual(s, want) {
+		t.Errorf("Stats is %+v, want %+v", s, want)
+	}
+}
+
+func TestVideo_unmarshalFixture(t *testing.T) {
+	data, err := os.ReadFile("testdata/video.json")
+	if err != nil {
+		t.Fatalf("os.ReadFile returned unexpected error: %v", err)
+	}
+
+	v := &Video{}
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatalf("json.Unmarshal returned unexpected error: %v", err)
+	}
+
+	if !v.IsPlayable || !v.HasAudio {
+		t.Errorf("Video is_playable/has_audio is %v/%v, want true/true", v.IsPlayable, v.HasAudio)
+	}
+
+	if v.ManageLink != "/manage/videos/76979871" {
+		t.Errorf("Video.ManageLink is %v, want %v", v.ManageLink, "/manage/videos/76979871")
+	}
+
+	wantEventDate := time.Date(2023, 2, 13, 8, 1, 55, 0, time.UTC)
+	if !v.LastUserActionEventDate.Equal(wantEventDate) {
+		t.Errorf("Video.LastUserActionEventDate is %v, want %v", v.LastUserActionEventDate, wantEventDate)
+	}
+
+	if v.ParentFolder == nil || v.ParentFolder.URI != "/users/152184/projects/13213851" {
+		t.Errorf("Video.ParentFolder is %+v, want URI %v", v.ParentFolder, "/users/152184/projects/13213851")
+	}
+
+	if v.Stats == nil || v.Stats.Plays != 1578362 {
+		t.Errorf("Video.Stats is %+v, want plays %v", v.Stats, 1578362)
+	}
+
+	comments := v.Metadata.Connections["comments"]
+	if comments == nil || comments.Total != 327 || comments.URI != "/videos/76979871/comments" {
+		t.Errorf("Video.Metadata comments connection is %+v", comments)
+	}
+
+	if versions := v.Metadata.Connections["versions"]; versions == nil || versions.CurrentURI != "/videos/76979871/versions/41299823" {
+		t.Errorf("Video.Metadata versions connection is %+v", versions)
+	}
+
+	like := v.Metadata.Interactions["like"]
+	if like == nil || !like.Added || like.AddedTime.IsZero() {
+		t.Errorf("Video.Metadata like interaction is %+v", like)
+	}
+
+	if report := v.Metadata.Interactions["report"]; report == nil || len(report.Reason) != 6 {
+		t.Errorf("Video.Metadata report interaction is %+v", report)
+	}
+
+	if v.Play == nil || v.Play.Status != "playable" || len(v.Play.Progressive) != 1 {
+		t.Fatalf("Video.Play is %+v", v.Play)
+	}
+
+	wantProgressive := &PlayProgressive{
+		Type:               "video/mp4",
+		Codec:              "H264",
+		Rendition:          "720p",
+		Width:              1280,
+		Height:             720,
+		Link:               "https://player.vimeo.com/progressive_redirect/playback/76979871/rendition/720p/file.mp4",
+		LinkExpirationTime: time.Date(2023, 2, 14, 9, 1, 55, 0, time.UTC),
+		Log:                "https://api.vimeo.com/videos/76979871:scrubbed/log/progressive",
+		CreatedTime:        time.Date(2013, 10, 15, 14, 20, 45, 0, time.UTC),
+		FPS:                23.98,
+		Size:               20218837,
+		MD5:                "scrubbed",
+	}
+	got := v.Play.Progressive[0]
+	got.LinkExpirationTime = got.LinkExpirationTime.UTC()
+	got.CreatedTime = got.CreatedTime.UTC()
+	if !reflect.DeepEqual(got, wantProgressive) {
+		t.Errorf("Video.Play.Progressive[0] is %+v, want %+v", got, wantProgressive)
+	}
+
+	if v.Play.HLS == nil || v.Play.HLS.Link != "https://player.vimeo.com/external/76979871.m3u8?s=scrubbed" {
+		t.Errorf("Video.Play.HLS is %+v", v.Play.HLS)
+	}
+
+	if v.Play.DASH == nil || v.Play.DASH.Link != "https://player.vimeo.com/external/76979871.mpd?s=scrubbed" {
+		t.Errorf("Video.Play.DASH is %+v", v.Play.DASH)
+	}
+
+	wantSpatial := &Spatial{
+		Projection:   "equirectangular",
+		StereoFormat: "mono",
+		DirectorTimeline: []*SpatialDirectorTimeline{
+			{Yaw: 90, TimeCode: 12.5},
+		},
+	}
+	if !reflect.DeepEqual(v.Spatial, wantSpatial) {
+		t.Errorf("Video.Spatial is %+v, want %+v", v.Spatial, wantSpatial)
+	}
+
+	if v.Embed == nil || v.Embed.Badges == nil || !v.Embed.Badges.StaffPick.Normal {
+		t.Errorf("Video.Embed.Badges is %+v", v.Embed.Badges)
+	}
+
+	if v.Embed.Logos == nil || !v.Embed.Logos.Vimeo || v.Embed.Logos.Custom == nil {
+		t.Errorf("Video.Embed.Logos is %+v", v.Embed.Logos)
+	}
+
+	if v.Embed.Title == nil || v.Embed.Title.Owner != "user" {
+		t.Errorf("Video.Embed.Title is %+v", v.Embed.Title)
+	}
+
+	if v.ReviewPage == nil || !v.ReviewPage.Active {
+		t.Errorf("Video.ReviewPage is %+v", v.ReviewPage)
+	}
+
+	if v.Uploader == nil || v.Uploader.Pictures == nil {
+		t.Errorf("Video.Uploader is %+v", v.Uploader)
+	}
+}
+
+func TestParseVideoID(t *testing.T) {
+	tests := []struct {
+		in   string
+		want VideoID
+	}{
+		{"123", VideoID{ID: 123}},
+		{"123:abcdef", VideoID{ID: 123, Hash: "abcdef"}},
+		{"/videos/123:abcdef", VideoID{ID: 123, Hash: "abcdef"}},
+		{"https://vimeo.com/123/abcdef", VideoID{ID: 123, Hash: "abcdef"}},
+		{"https://player.vimeo.com/video/123?h=abcdef", VideoID{ID: 123, Hash: "abcdef"}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseVideoID(tt.in)
+		if err != nil {
+			t.Errorf("ParseVideoID(%q) returned unexpected error: %v", tt.in, err)
+		}
+		if got != tt.want {
+			t.Errorf("ParseVideoID(%q) returned %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+
+	if _, err := ParseVideoID("https://vimeo.com/showcase/1"); err == nil {
+		t.Errorf("ParseVideoID expected error for a showcase link")
+	}
+}
+
+func TestVideoID_String(t *testing.T) {
+	if s := (VideoID{ID: 123}).String(); s != "123" {
+		t.Errorf("VideoID.String returned %v, want %v", s, "123")
+	}
+
+	if s := (VideoID{ID: 123, Hash: "abcdef"}).String(); s != "123:abcdef" {
+		t.Errorf("VideoID.String returned %v, want %v", s, "123:abcdef")
+	}
+}
+
+func TestVideo_GetVideoID(t *testing.T) {
+	tests := []struct {
+		video *Video
+		want  VideoID
+	}{
+		{&Video{URI: "/videos/123"}, VideoID{ID: 123}},
+		{&Video{URI: "/videos/123:abcdef"}, VideoID{ID: 123, Hash: "abcdef"}},
+		{&Video{URI: "/videos/123", Link: "https://vimeo.com/123/abcdef"}, VideoID{ID: 123, Hash: "abcdef"}},
+		{&Video{URI: "/videos/123", Link: "https://vimeo.com/123"}, VideoID{ID: 123}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.video.GetVideoID(); got != tt.want {
+			t.Errorf("Video.GetVideoID(%+v) returned %+v, want %+v", tt.video, got, tt.want)
+		}
+	}
+}
+
 func TestVideosService_List(t *testing.T) {
 	setup()
 	defer teardown()
@@ -49,7 +237,31 @@ func TestVideosService_Get(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := client.Videos.Get(1)
+	video, _, err := client.Videos.Get(VideoID{ID: 1})
+	if err != nil {
+		t.Errorf("Videos.Get returned unexpected error: %v", err)
+	}
+
+	want := &Video{Name: "Test"}
+	if !reflect.DeepEqual(video, want) {
+		t.Errorf("Videos.Get returned %+v, want %+v", video, want)
+	}
+}
+
+func TestVideosService_Get_unlisted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1:abcdef", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"fields": "name",
+		})
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	id := VideoID{ID: 1, Hash: "abcdef"}
+	video, _, err := client.Videos.Get(id, OptFields([]string{"name"}))
 	if err != nil {
 		t.Errorf("Videos.Get returned unexpected error: %v", err)
 	}
@@ -65,8 +277,8 @@ func TestVideosService_Edit(t *testing.T) {
 	defer teardown()
 
 	input := &VideoRequest{
-		Name:        "name",
-		Description: "desc",
+		Name:        String("name"),
+		Description: String("desc"),
 	}
 
 	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
@@ -84,7 +296,7 @@ func TestVideosService_Edit(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	video, _, err := client.Videos.Edit(1, input)
+	video, _, err := client.Videos.Edit(VideoID{ID: 1}, input)
 	if err != nil {
 		t.Errorf("Videos.Edit returned unexpected error: %v", err)
 	}
@@ -95,6 +307,52 @@ func TestVideosService_Edit(t *testing.T) {
 	}
 }
 
+func TestVideosService_Edit_unlisted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1:abcdef", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"name": "name"}`)
+	})
+
+	_, _, err := client.Videos.Edit(VideoID{ID: 1, Hash: "abcdef"}, &VideoRequest{})
+	if err != nil {
+		t.Errorf("Videos.Edit returned unexpected error: %v", err)
+	}
+}
+
+func TestVideosService_Edit_partialEmbed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"embed":{"loop":false,"color":"ff0000"},"review_page":{"active":true}}`+"\n")
+		fmt.Fprint(w, `{"name": "name"}`)
+	})
+
+	input := NewVideoRequest().
+		SetEmbed(NewEmbedRequest().SetColor("ff0000").SetLoop(false)).
+		SetReviewPage(true)
+
+	_, _, err := client.Videos.Edit(VideoID{ID: 1}, input)
+	if err != nil {
+		t.Errorf("Videos.Edit returned unexpected error: %v", err)
+	}
+}
+
+func TestVideoRequest_marshalEmpty(t *testing.T) {
+	b, err := json.Marshal(NewVideoRequest().SetEmbed(NewEmbedRequest()))
+	if err != nil {
+		t.Fatalf("json.Marshal returned unexpected error: %v", err)
+	}
+
+	if want := `{"embed":{}}`; string(b) != want {
+		t.Errorf("json.Marshal returned %s, want %s", b, want)
+	}
+}
+
 func TestVideosService_Delete(t *testing.T) {
 	setup()
 	defer teardown()
@@ -185,7 +443,7 @@ func TestVideosService_AddComment(t *testing.T) {
 	defer teardown()
 
 	input := &CommentRequest{
-		Text: "name",
+		Text: String("name"),
 	}
 
 	mux.HandleFunc("/videos/1/comments", func(w http.ResponseWriter, r *http.Request) {
@@ -219,7 +477,7 @@ func TestVideosService_EditComment(t *testing.T) {
 	defer teardown()
 
 	input := &CommentRequest{
-		Text: "name",
+		Text: String("name"),
 	}
 
 	mux.HandleFunc("/videos/1/comments/1", func(w http.ResponseWriter, r *http.Request) {
@@ -291,7 +549,7 @@ func TestVideosService_AddReplies(t *testing.T) {
 	defer teardown()
 
 	input := &CommentRequest{
-		Text: "name",
+		Text: String("name"),
 	}
 
 	mux.HandleFunc("/videos/1/comments/1/replies", func(w http.ResponseWriter, r *http.Request) {
@@ -372,7 +630,7 @@ func TestVideosService_AddCredit(t *testing.T) {
 	defer teardown()
 
 	input := &CreditRequest{
-		Name: "name",
+		Name: String("name"),
 	}
 
 	mux.HandleFunc("/videos/1/credits", func(w http.ResponseWriter, r *http.Request) {
@@ -406,7 +664,7 @@ func TestVideosService_EditCredit(t *testing.T) {
 	defer teardown()
 
 	input := &CreditRequest{
-		Name: "name",
+		Name: String("name"),
 	}
 
 	mux.HandleFunc("/videos/1/credits/1", func(w http.ResponseWriter, r *http.Request) {
@@ -458,7 +716,7 @@ func TestVideosService_ListPictures(t *testing.T) {
 		fmt.Fprint(w, `{"data": [{"uri": "Test"}]}`)
 	})
 
-	pictures, _, err := client.Videos.ListPictures(1)
+	pictures, _, err := client.Videos.ListPictures(VideoID{ID: 1})
 	if err != nil {
 		t.Errorf("Videos.ListPictures returned unexpected error: %v", err)
 	}
@@ -469,12 +727,28 @@ func TestVideosService_ListPictures(t *testing.T) {
 	}
 }
 
+func TestVideosService_ListPictures_unlisted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1:abcdef/pictures", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{})
+		fmt.Fprint(w, `{"data": [{"uri": "Test"}]}`)
+	})
+
+	_, _, err := client.Videos.ListPictures(VideoID{ID: 1, Hash: "abcdef"})
+	if err != nil {
+		t.Errorf("Videos.ListPictures returned unexpected error: %v", err)
+	}
+}
+
 func TestVideosService_CreatePictures(t *testing.T) {
 	setup()
 	defer teardown()
 
 	input := &PicturesRequest{
-		Active: true,
+		Active: Bool(true),
 	}
 
 	mux.HandleFunc("/videos/1/pictures", func(w http.ResponseWriter, r *http.Request) {
@@ -492,7 +766,7 @@ func TestVideosService_CreatePictures(t *testing.T) {
 		fmt.Fprint(w, `{"uri": "name"}`)
 	})
 
-	pictures, _, err := client.Videos.CreatePictures(1, input)
+	pictures, _, err := client.Videos.CreatePictures(VideoID{ID: 1}, input)
 	if err != nil {
 		t.Errorf("Videos.CreatePictures returned unexpected error: %v", err)
 	}
@@ -512,7 +786,7 @@ func TestVideosService_GetPictures(t *testing.T) {
 		fmt.Fprint(w, `{"uri": "Test"}`)
 	})
 
-	pictures, _, err := client.Videos.GetPictures(1, 1)
+	pictures, _, err := client.Videos.GetPictures(VideoID{ID: 1}, 1)
 	if err != nil {
 		t.Errorf("Videos.GetPictures returned unexpected error: %v", err)
 	}
@@ -528,7 +802,7 @@ func TestVideosService_EditPictures(t *testing.T) {
 	defer teardown()
 
 	input := &PicturesRequest{
-		Active: true,
+		Active: Bool(true),
 	}
 
 	mux.HandleFunc("/videos/1/pictures/1", func(w http.ResponseWriter, r *http.Request) {
@@ -546,7 +820,7 @@ func TestVideosService_EditPictures(t *testing.T) {
 		fmt.Fprint(w, `{"uri": "name"}`)
 	})
 
-	pictures, _, err := client.Videos.EditPictures(1, 1, input)
+	pictures, _, err := client.Videos.EditPictures(VideoID{ID: 1}, 1, input)
 	if err != nil {
 		t.Errorf("Videos.EditPictures returned unexpected error: %v", err)
 	}
@@ -565,7 +839,7 @@ func TestVideosService_DeletePictures(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Videos.DeletePictures(1, 1)
+	_, err := client.Videos.DeletePictures(VideoID{ID: 1}, 1)
 	if err != nil {
 		t.Errorf("Videos.DeletePictures returned unexpected error: %v", err)
 	}
@@ -580,7 +854,7 @@ func TestVideosService_GetPreset(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	preset, _, err := client.Videos.GetPreset(1, 1)
+	preset, _, err := client.Videos.GetPreset(VideoID{ID: 1}, 1)
 	if err != nil {
 		t.Errorf("Videos.GetPreset returned unexpected error: %v", err)
 	}
@@ -599,7 +873,7 @@ func TestVideosService_AssignPreset(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Videos.AssignPreset(1, 1)
+	_, err := client.Videos.AssignPreset(VideoID{ID: 1}, 1)
 	if err != nil {
 		t.Errorf("Videos.AssignPreset returned unexpected error: %v", err)
 	}
@@ -613,7 +887,7 @@ func TestVideosService_UnassignPreset(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Videos.UnassignPreset(1, 1)
+	_, err := client.Videos.UnassignPreset(VideoID{ID: 1}, 1)
 	if err != nil {
 		t.Errorf("Videos.UnassignPreset returned unexpected error: %v", err)
 	}
@@ -628,7 +902,7 @@ func TestVideosService_ListDomain(t *testing.T) {
 		fmt.Fprint(w, `{"data": [{"uri": "Test"}]}`)
 	})
 
-	domains, _, err := client.Videos.ListDomain(1)
+	domains, _, err := client.Videos.ListDomain(VideoID{ID: 1})
 	if err != nil {
 		t.Errorf("Videos.ListDomain returned unexpected error: %v", err)
 	}
@@ -647,7 +921,7 @@ func TestVideosService_AllowDomain(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Videos.AllowDomain(1, "1")
+	_, err := client.Videos.AllowDomain(VideoID{ID: 1}, "1")
 	if err != nil {
 		t.Errorf("Videos.AllowDomain returned unexpected error: %v", err)
 	}
@@ -661,7 +935,7 @@ func TestVideosService_DisallowDomain(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Videos.DisallowDomain(1, "1")
+	_, err := client.Videos.DisallowDomain(VideoID{ID: 1}, "1")
 	if err != nil {
 		t.Errorf("Videos.DisallowDomain returned unexpected error: %v", err)
 	}
@@ -693,12 +967,19 @@ func TestVideosService_AllowUsers(t *testing.T) {
 
 	mux.HandleFunc("/videos/1/privacy/users", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PUT")
+		testBody(t, r, `[{"uri":"/users/1"},{"uri":"/users/2"},{"uri":"/users/3"}]`+"\n")
+		fmt.Fprint(w, `[{"uri": "/users/1"}, {"uri": "/users/2"}, {"uri": "/users/3"}]`)
 	})
 
-	_, err := client.Videos.AllowUsers(1)
+	users, _, err := client.Videos.AllowUsers(1, []string{"1", "/users/2", "https://vimeo.com/user3"})
 	if err != nil {
 		t.Errorf("Videos.AllowUsers returned unexpected error: %v", err)
 	}
+
+	want := []*User{{URI: "/users/1"}, {URI: "/users/2"}, {URI: "/users/3"}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("Videos.AllowUsers returned %+v, want %+v", users, want)
+	}
 }
 
 func TestVideosService_AllowUser(t *testing.T) {
@@ -738,7 +1019,7 @@ func TestVideosService_ListTag(t *testing.T) {
 		fmt.Fprint(w, `{"data": [{"uri": "Test"}]}`)
 	})
 
-	tags, _, err := client.Videos.ListTag(1)
+	tags, _, err := client.Videos.ListTag(VideoID{ID: 1})
 	if err != nil {
 		t.Errorf("Videos.ListTag returned unexpected error: %v", err)
 	}
@@ -758,7 +1039,7 @@ func TestVideosService_GetTag(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	tag, _, err := client.Videos.GetTag(1, "1")
+	tag, _, err := client.Videos.GetTag(VideoID{ID: 1}, "1")
 	if err != nil {
 		t.Errorf("Videos.GetTag returned unexpected error: %v", err)
 	}
@@ -777,7 +1058,7 @@ func TestVideosService_AssignTag(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Videos.AssignTag(1, "1")
+	_, err := client.Videos.AssignTag(VideoID{ID: 1}, "1")
 	if err != nil {
 		t.Errorf("Videos.AssignTag returned unexpected error: %v", err)
 	}
@@ -791,12 +1072,27 @@ func TestVideosService_UnassignTag(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Videos.UnassignTag(1, "1")
+	_, err := client.Videos.UnassignTag(VideoID{ID: 1}, "1")
 	if err != nil {
 		t.Errorf("Videos.UnassignTag returned unexpected error: %v", err)
 	}
 }
 
+func TestVideosService_GetTag_unlisted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1:abcdef/tags/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	_, _, err := client.Videos.GetTag(VideoID{ID: 1, Hash: "abcdef"}, "1")
+	if err != nil {
+		t.Errorf("Videos.GetTag returned unexpected error: %v", err)
+	}
+}
+
 func TestVideosService_ListTextTrack(t *testing.T) {
 	setup()
 	defer teardown()
@@ -806,7 +1102,7 @@ func TestVideosService_ListTextTrack(t *testing.T) {
 		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
 	})
 
-	textTrack, _, err := client.Videos.ListTextTrack(1)
+	textTrack, _, err := client.Videos.ListTextTrack(VideoID{ID: 1})
 	if err != nil {
 		t.Errorf("Videos.ListTextTrack returned unexpected error: %v", err)
 	}
@@ -817,12 +1113,27 @@ func TestVideosService_ListTextTrack(t *testing.T) {
 	}
 }
 
+func TestVideosService_ListTextTrack_unlisted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1:abcdef/texttracks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	_, _, err := client.Videos.ListTextTrack(VideoID{ID: 1, Hash: "abcdef"})
+	if err != nil {
+		t.Errorf("Videos.ListTextTrack returned unexpected error: %v", err)
+	}
+}
+
 func TestVideosService_AddTextTrack(t *testing.T) {
 	setup()
 	defer teardown()
 
 	input := &TextTrackRequest{
-		Name: "name",
+		Name: String("name"),
 	}
 
 	mux.HandleFunc("/videos/1/texttracks", func(w http.ResponseWriter, r *http.Request) {
@@ -840,7 +1151,7 @@ func TestVideosService_AddTextTrack(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	textTrack, _, err := client.Videos.AddTextTrack(1, input)
+	textTrack, _, err := client.Videos.AddTextTrack(VideoID{ID: 1}, input)
 	if err != nil {
 		t.Errorf("Videos.AddTextTrack returned unexpected error: %v", err)
 	}
@@ -860,7 +1171,7 @@ func TestVideosService_GetTextTrack(t *testing.T) {
 		fmt.Fprint(w, `{"uri": "Test"}`)
 	})
 
-	textTrack, _, err := client.Videos.GetTextTrack(1, 1)
+	textTrack, _, err := client.Videos.GetTextTrack(VideoID{ID: 1}, 1)
 	if err != nil {
 		t.Errorf("Videos.GetTextTrack returned unexpected error: %v", err)
 	}
@@ -876,7 +1187,7 @@ func TestVideosService_EditTextTrack(t *testing.T) {
 	defer teardown()
 
 	input := &TextTrackRequest{
-		Name: "name",
+		Name: String("name"),
 	}
 
 	mux.HandleFunc("/videos/1/texttracks/1", func(w http.ResponseWriter, r *http.Request) {
@@ -894,7 +1205,7 @@ func TestVideosService_EditTextTrack(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	textTrack, _, err := client.Videos.EditTextTrack(1, 1, input)
+	textTrack, _, err := client.Videos.EditTextTrack(VideoID{ID: 1}, 1, input)
 	if err != nil {
 		t.Errorf("Videos.EditTextTrack returned unexpected error: %v", err)
 	}
@@ -913,7 +1224,7 @@ func TestVideosService_DeleteTextTrack(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Videos.DeleteTextTrack(1, 1)
+	_, err := client.Videos.DeleteTextTrack(VideoID{ID: 1}, 1)
 	if err != nil {
 		t.Errorf("Videos.DeleteTextTrack returned unexpected error: %v", err)
 	}
@@ -1020,3 +1331,534 @@ func TestVideosService_uploadVideoByURL(t *testing.T) {
 		t.Errorf("Videos.Get returned %+v, want %+v", video, want)
 	}
 }
+
+func TestVideosService_GetMany(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var calls int
+
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		mu.Lock()
+		calls++
+		mu.Unlock()
+
+		uris := strings.Split(r.URL.Query().Get("uris"), ",")
+		if got := r.URL.Query().Get("per_page"); got != strconv.Itoa(len(uris)) {
+			t.Errorf("Videos.GetMany per_page is %v, want %v", got, len(uris))
+		}
+
+		var data []string
+		for _, uri := range uris {
+			// Video 7 doesn't exist.
+			if uri != "/videos/7" {
+				data = append(data, fmt.Sprintf(`{"uri": %q}`, uri))
+			}
+		}
+		fmt.Fprintf(w, `{"data": [%s]}`, strings.Join(data, ","))
+	})
+
+	ids := make([]int, 150)
+	for i := range ids {
+		ids[i] = 150 - i
+	}
+
+	result, err := client.Videos.GetMany(ids)
+	if err != nil {
+		t.Fatalf("Videos.GetMany returned unexpected error: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Videos.GetMany sent %v requests, want %v", calls, 2)
+	}
+
+	if len(result.Videos) != len(ids) {
+		t.Fatalf("Videos.GetMany returned %v videos, want %v", len(result.Videos), len(ids))
+	}
+
+	for i, id := range ids {
+		if id == 7 {
+			if result.Videos[i] != nil {
+				t.Errorf("Videos.GetMany returned %+v for missing video", result.Videos[i])
+			}
+			continue
+		}
+		if result.Videos[i] == nil || result.Videos[i].GetID() != id {
+			t.Errorf("Videos.GetMany returned %+v at %v, want video %v", result.Videos[i], i, id)
+		}
+	}
+
+	if !reflect.DeepEqual(result.Missing, []int{7}) {
+		t.Errorf("Videos.GetMany missing is %v, want %v", result.Missing, []int{7})
+	}
+
+	if len(result.Forbidden) != 0 {
+		t.Errorf("Videos.GetMany forbidden is %v, want none", result.Forbidden)
+	}
+}
+
+func TestVideosService_GetMany_options(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"uris":     "/videos/1,/videos/2,/videos/3",
+			"per_page": "3",
+			"fields":   "name,uri",
+		})
+		fmt.Fprint(w, `{"data": [{"uri": "/videos/1", "name": "a"}, {"uri": "/videos/2", "name": "b"}, {"uri": "/videos/3", "name": "c"}]}`)
+	})
+
+	result, err := client.Videos.GetMany([]int{1, 2, 3}, OptFields{"name"}, OptPage(2), OptPerPage(1))
+	if err != nil {
+		t.Fatalf("Videos.GetMany returned unexpected error: %v", err)
+	}
+
+	if len(result.Missing) != 0 {
+		t.Errorf("Videos.GetMany returned missing %v, want none", result.Missing)
+	}
+	if result.Videos[2] == nil || result.Videos[2].Name != "c" {
+		t.Errorf("Videos.GetMany returned %+v", result.Videos)
+	}
+}
+
+func TestVideosService_GetMany_forbidden(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": "Forbidden"}`)
+	})
+
+	result, err := client.Videos.GetMany([]int{1, 2})
+	if err != nil {
+		t.Fatalf("Videos.GetMany returned unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(result.Forbidden, []int{1, 2}) {
+		t.Errorf("Videos.GetMany forbidden is %v, want %v", result.Forbidden, []int{1, 2})
+	}
+}
+
+func TestVideosService_GetMany_forbiddenSplit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		uris := strings.Split(r.URL.Query().Get("uris"), ",")
+
+		var data []string
+		for _, uri := range uris {
+			// Video 3 is forbidden and the API refuses any batch that contains it.
+			if uri == "/videos/3" {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{"error": "Forbidden"}`)
+				return
+			}
+			data = append(data, fmt.Sprintf(`{"uri": %q}`, uri))
+		}
+		fmt.Fprintf(w, `{"data": [%s]}`, strings.Join(data, ","))
+	})
+
+	ids := []int{1, 2, 3, 4, 5}
+	result, err := client.Videos.GetMany(ids)
+	if err != nil {
+		t.Fatalf("Videos.GetMany returned unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(result.Forbidden, []int{3}) {
+		t.Errorf("Videos.GetMany forbidden is %v, want %v", result.Forbidden, []int{3})
+	}
+
+	for i, id := range ids {
+		if id == 3 {
+			continue
+		}
+		if result.Videos[i] == nil || result.Videos[i].GetID() != id {
+			t.Errorf("Videos.GetMany returned %+v at %v, want video %v", result.Videos[i], i, id)
+		}
+	}
+}
+
+func TestVideosService_GetMany_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"error": "Server error"}`)
+	})
+
+	if _, err := client.Videos.GetMany([]int{1, 2}); err == nil {
+		t.Errorf("Videos.GetMany expected error")
+	}
+}
+
+func TestDiffVideo(t *testing.T) {
+	current := &Video{
+		URI:           "/videos/1",
+		Name:          "Test",
+		Description:   "desc",
+		ContentRating: []ContentRatingCode{RatingSafe},
+		Privacy:       &VideoPrivacy{View: ViewAnybody, Download: true},
+		Embed:         &Embed{Color: "#FF0000", PlayBar: true, Buttons: &Buttons{Like: Bool(true)}},
+		ReviewPage:    &ReviewPage{Active: true},
+		Tags:          []*Tag{{Tag: "go"}, {Tag: "old"}},
+		EmbedPresets:  &EmbedPresets{URI: "/users/1/presets/10"},
+	}
+
+	desired := &VideoState{
+		Name:          String("Test"),
+		Description:   String("new desc"),
+		ContentRating: []ContentRatingCode{RatingSafe},
+		Privacy:       (&PrivacyRequest{}).SetView(ViewAnybody).SetDownload(false),
+		Embed:         NewEmbedRequest().SetColor("ff0000").SetPlayBar(false).SetLoop(true).SetButtons(&Buttons{Like: Bool(true), Share: Bool(false)}),
+		ReviewPage:    Bool(true),
+		Tags:          []string{"Go", "new"},
+		Preset:        Int(20),
+		Domains:       []string{"example.com", "example.org"},
+	}
+
+	domains := []*Domain{{Name: "example.com"}, {Name: "example.net"}}
+
+	d := DiffVideo(current, domains, desired)
+
+	want := &VideoDiff{
+		Request: &VideoRequest{
+			Description: String("new desc"),
+			Privacy:     &PrivacyRequest{Download: Bool(false)},
+			Embed: &EmbedRequest{
+				Loop:    Bool(true),
+				PlayBar: Bool(false),
+				Buttons: &Buttons{Share: Bool(false)},
+			},
+		},
+		Fields:          []string{"description", "privacy.download", "embed.loop", "embed.playbar", "embed.buttons.share"},
+		AddTags:         []string{"new"},
+		RemoveTags:      []string{"old"},
+		AssignPreset:    20,
+		UnassignPreset:  10,
+		AllowDomains:    []string{"example.org"},
+		DisallowDomains: []string{"example.net"},
+	}
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("DiffVideo returned %+v, want %+v", d, want)
+	}
+}
+
+func TestDiffVideo_noChange(t *testing.T) {
+	current := &Video{Name: "Test", Tags: []*Tag{{Tag: "go"}}}
+
+	d := DiffVideo(current, nil, &VideoState{Name: String("Test"), Tags: []string{"go"}})
+	if !d.Empty() {
+		t.Errorf("DiffVideo returned %+v, want an empty diff", d)
+	}
+}
+
+func TestDiffVideo_nil(t *testing.T) {
+	if d := DiffVideo(&Video{Name: "Test"}, nil, nil); !d.Empty() {
+		t.Errorf("DiffVideo returned %+v, want an empty diff", d)
+	}
+
+	d := DiffVideo(nil, nil, &VideoState{Name: String("Test")})
+	if !reflect.DeepEqual(d.Fields, []string{"name"}) {
+		t.Errorf("DiffVideo fields are %v, want %v", d.Fields, []string{"name"})
+	}
+}
+
+func TestVideosService_Apply(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+	}
+
+	mux.HandleFunc("/videos/1:abcdef", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"name":"new"}`+"\n")
+		record(w, r)
+		fmt.Fprint(w, `{"name": "new"}`)
+	})
+	mux.HandleFunc("/videos/1:abcdef/tags/", record)
+	mux.HandleFunc("/videos/1:abcdef/privacy/domains", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "example.com"}]}`)
+	})
+	mux.HandleFunc("/videos/1:abcdef/privacy/domains/", record)
+
+	current := &Video{URI: "/videos/1:abcdef", Name: "old", Tags: []*Tag{{Tag: "a"}}}
+	desired := &VideoState{
+		Name:    String("new"),
+		Tags:    []string{"b"},
+		Domains: []string{"example.org"},
+	}
+
+	d, err := client.Videos.Apply(current, desired)
+	if err != nil {
+		t.Fatalf("Videos.Apply returned unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(d.Fields, []string{"name"}) {
+		t.Errorf("Videos.Apply fields are %v, want %v", d.Fields, []string{"name"})
+	}
+
+	want := []string{
+		"PATCH /videos/1:abcdef",
+		"DELETE /videos/1:abcdef/tags/a",
+		"PUT /videos/1:abcdef/tags/b",
+		"DELETE /videos/1:abcdef/privacy/domains/example.com",
+		"PUT /videos/1:abcdef/privacy/domains/example.org",
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("Videos.Apply sent %v, want %v", calls, want)
+	}
+}
+
+func TestVideosService_Apply_nil(t *testing.T) {
+	if _, err := client.Videos.Apply(nil, &VideoState{Name: String("new")}); err == nil {
+		t.Errorf("Videos.Apply expected error for a nil video")
+	}
+	if _, err := client.Videos.Apply(&Video{URI: "/videos/1"}, nil); err == nil {
+		t.Errorf("Videos.Apply expected error for a nil state")
+	}
+}
+
+func setupDomainSync(t *testing.T) (calls func() []string) {
+	var mu sync.Mutex
+	var sent []string
+
+	mux.HandleFunc("/users/1/projects/2/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"uri": "/videos/1"}, {"uri": "/videos/2"}, {"uri": "/videos/3"}]}`)
+	})
+	mux.HandleFunc("/videos/1/privacy/domains", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": [{"name": "example.com"}, {"name": "old.example.com"}]}`)
+	})
+	mux.HandleFunc("/videos/2/privacy/domains", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": [{"name": "example.com"}, {"name": "staging.example.com"}]}`)
+	})
+	mux.HandleFunc("/videos/3/privacy/domains", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error": "boom"}`, http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/videos/1/privacy/domains/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		sent = append(sent, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+	})
+
+	return func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return sent
+	}
+}
+
+func TestVideosService_SyncDomains(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := setupDomainSync(t)
+
+	report, err := client.Videos.SyncDomains(&DomainSync{
+		Domains: []string{"example.com", "staging.example.com"},
+		List:    client.Users.FolderScope("/users/1/projects/2"),
+	})
+	if err != nil {
+		t.Fatalf("Videos.SyncDomains returned unexpected error: %v", err)
+	}
+
+	if len(report.Changes) != 2 || report.Changes[0].VideoID != 1 || report.Changes[1].VideoID != 3 || report.Changes[1].Err == nil {
+		t.Errorf("Videos.SyncDomains returned changes %+v", report.Changes)
+	}
+	if want := "3 videos, 1 changed, 1 added, 1 removed, 1 failed"; report.String() != want {
+		t.Errorf("SyncReport.String returned %q, want %q", report.String(), want)
+	}
+
+	want := []string{"PUT /videos/1/privacy/domains/staging.example.com", "DELETE /videos/1/privacy/domains/old.example.com"}
+	if !reflect.DeepEqual(calls(), want) {
+		t.Errorf("Videos.SyncDomains sent %v, want %v", calls(), want)
+	}
+}
+
+func TestUsersService_scopes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, path := range []string{"/users/1/projects/2/videos", "/me/albums/3/videos", "/me/videos"} {
+		path := path
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			fmt.Fprintf(w, `{"data": [{"uri": "/videos/1", "name": %q}]}`, path)
+		})
+	}
+
+	scopes := map[string]ListFunc[Video]{
+		"/users/1/projects/2/videos": client.Users.FolderScope("/users/1/projects/2"),
+		"/me/albums/3/videos":        client.Users.AlbumScope("", "3"),
+		"/me/videos":                 client.Users.LibraryScope(""),
+	}
+	for path, list := range scopes {
+		videos, _, err := list()
+		if err != nil {
+			t.Fatalf("scope %s returned unexpected error: %v", path, err)
+		}
+		if len(videos) != 1 || videos[0].Name != path {
+			t.Errorf("scope %s returned %+v", path, videos)
+		}
+	}
+}
+
+func TestVideosService_SyncDomains_dryRun(t *testing.T) {
+	setup()
+	defer teardown()
+	calls := setupDomainSync(t)
+
+	report, err := client.Videos.SyncDomains(&DomainSync{
+		Domains: []string{"example.com", "staging.example.com"},
+		Videos:  []int{1, 2},
+		DryRun:  true,
+	})
+	if err != nil {
+		t.Fatalf("Videos.SyncDomains returned unexpected error: %v", err)
+	}
+
+	if len(calls()) != 0 {
+		t.Errorf("Videos.SyncDomains sent %v in a dry run", calls())
+	}
+
+	var b strings.Builder
+	if _, err := report.WriteTo(&b); err != nil {
+		t.Fatalf("SyncReport.WriteTo returned unexpected error: %v", err)
+	}
+	want := "/videos/1\n  + staging.example.com\n  - old.example.com\n2 videos, 1 changed, 1 added, 1 removed, 0 failed (dry run)\n"
+	if b.String() != want {
+		t.Errorf("SyncReport.WriteTo wrote %q, want %q", b.String(), want)
+	}
+}
+
+func TestScopeIDs_dedupe(t *testing.T) {
+	list := func(opt ...CallOption) ([]*Video, *Response, error) {
+		return []*Video{{URI: "/videos/3"}, {URI: "/videos/1"}, {URI: "/videos/4"}, {URI: "/videos/3"}}, &Response{}, nil
+	}
+
+	ids, err := scopeIDs([]int{2, 1, 2}, list)
+	if err != nil {
+		t.Fatalf("scopeIDs returned unexpected error: %v", err)
+	}
+
+	if want := []int{2, 1, 3, 4}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("scopeIDs returned %v, want %v", ids, want)
+	}
+}
+
+func TestVideosService_SyncFolderViewers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var sent []string
+	record := func(r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		sent = append(sent, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+string(b)))
+		mu.Unlock()
+	}
+
+	mux.HandleFunc("/users/1/projects/2/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"uri": "/videos/1"}, {"uri": "/videos/2"}]}`)
+	})
+	mux.HandleFunc("/videos/1/privacy/users", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"data": [{"uri": "/users/10"}, {"uri": "/users/11"}]}`)
+			return
+		}
+		record(r)
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/videos/1/privacy/users/", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+	})
+	mux.HandleFunc("/videos/2/privacy/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"uri": "/users/10"}, {"uri": "/users/12"}]}`)
+	})
+
+	users := []string{"10", "https://vimeo.com/user12"}
+
+	report, err := client.Videos.SyncFolderViewers("/users/1/projects/2", users, true)
+	if err != nil {
+		t.Fatalf("Videos.SyncFolderViewers returned unexpected error: %v", err)
+	}
+	if len(sent) != 0 {
+		t.Errorf("Videos.SyncFolderViewers sent %v in a dry run", sent)
+	}
+	if want := "2 videos, 1 changed, 1 added, 1 removed, 0 failed (dry run)"; report.String() != want {
+		t.Errorf("SyncReport.String returned %q, want %q", report.String(), want)
+	}
+
+	if _, err := client.Videos.SyncFolderViewers("/users/1/projects/2", users, false); err != nil {
+		t.Fatalf("Videos.SyncFolderViewers returned unexpected error: %v", err)
+	}
+	want := []string{`PUT /videos/1/privacy/users [{"uri":"/users/12"}]`, "DELETE /videos/1/privacy/users/11"}
+	if !reflect.DeepEqual(sent, want) {
+		t.Errorf("Videos.SyncFolderViewers sent %v, want %v", sent, want)
+	}
+}
+
+func TestVideosService_SetViewers_customURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent []string
+	mux.HandleFunc("/users/alice", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{"fields": "uri"})
+		fmt.Fprint(w, `{"uri": "/users/10"}`)
+	})
+	mux.HandleFunc("/videos/1/privacy/users", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			sent = append(sent, r.Method+" "+r.URL.Path)
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		fmt.Fprint(w, `{"data": [{"uri": "/users/10"}, {"uri": "/users/11"}]}`)
+	})
+	mux.HandleFunc("/videos/1/privacy/users/", func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Method+" "+r.URL.Path)
+	})
+
+	c, err := client.Videos.SetViewers(1, []string{"https://vimeo.com/alice"})
+	if err != nil {
+		t.Fatalf("Videos.SetViewers returned unexpected error: %v", err)
+	}
+
+	if len(c.Add) != 0 || !reflect.DeepEqual(c.Remove, []string{"/users/11"}) {
+		t.Errorf("Videos.SetViewers returned %+v, want only /users/11 removed", c)
+	}
+	want := []string{"DELETE /videos/1/privacy/users/11"}
+	if !reflect.DeepEqual(sent, want) {
+		t.Errorf("Videos.SetViewers sent %v, want %v", sent, want)
+	}
+}
+
+func TestVideosService_AllowUsers_notUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/privacy/users", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Videos.AllowUsers sent a request")
+	})
+
+	for _, u := range []string{"https://vimeo.com/76979871", "/videos/1", "not a user"} {
+		if _, _, err := client.Videos.AllowUsers(1, []string{u}); err == nil {
+			t.Errorf("Videos.AllowUsers(%q) expected error", u)
+		}
+	}
+}
@@ -15,18 +15,19 @@ type TextTrack struct {
 }
 
 // TextTrackRequest represents a request to create/edit text track.
+// Nil fields are left out of the request.
 type TextTrackRequest struct {
-	Active   bool   `json:"role"`
-	Type     string `json:"type,omitempty"`
-	Language string `json:"language,omitempty"`
-	Name     string `json:"name,omitempty"`
+	Active   *bool   `json:"active,omitempty"`
+	Type     *string `json:"type,omitempty"`
+	Language *string `json:"language,omitempty"`
+	Name     *string `json:"name,omitempty"`
 }
 
 // ListTextTrack method returns all the text tracks of the specified video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_text_tracks
-func (s *VideosService) ListTextTrack(vid int, opt ...CallOption) ([]*TextTrack, *Response, error) {
-	u, err := addOptions(fmt.Sprintf("/videos/%d/texttracks", vid), opt...)
+func (s *VideosService) ListTextTrack(vid VideoID, opt ...CallOption) ([]*TextTrack, *Response, error) {
+	u, err := addOptions("/"+vid.path()+"/texttracks", opt...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -51,8 +52,8 @@ func (s *VideosService) ListTextTrack(vid int, opt ...CallOption) ([]*TextTrack,
 // AddTextTrack method adds a text track to the specified video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#create_text_track
-func (s *VideosService) AddTextTrack(vid int, r *TextTrackRequest) (*TextTrack, *Response, error) {
-	u := fmt.Sprintf("/videos/%d/texttracks", vid)
+func (s *VideosService) AddTextTrack(vid VideoID, r *TextTrackRequest, opt ...CallOption) (*TextTrack, *Response, error) {
+	u := "/" + vid.path() + "/texttracks"
 	req, err := s.client.NewRequest("POST", u, r)
 	if err != nil {
 		return nil, nil, err
@@ -71,8 +72,8 @@ func (s *VideosService) AddTextTrack(vid int, r *TextTrackRequest) (*TextTrack,
 // GetTextTrack method returns a single text track from the specified video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#get_text_track
-func (s *VideosService) GetTextTrack(vid int, tid int, opt ...CallOption) (*TextTrack, *Response, error) {
-	u, err := addOptions(fmt.Sprintf("videos/%d/texttracks/%d", vid, tid), opt...)
+func (s *VideosService) GetTextTrack(vid VideoID, tid int, opt ...CallOption) (*TextTrack, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("%s/texttracks/%d", vid.path(), tid), opt...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -95,8 +96,8 @@ func (s *VideosService) GetTextTrack(vid int, tid int, opt ...CallOption) (*Text
 // EditTextTrack method edits the specified text track.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#edit_text_track
-func (s *VideosService) EditTextTrack(vid int, tid int, r *TextTrackRequest) (*TextTrack, *Response, error) {
-	u := fmt.Sprintf("videos/%d/texttracks/%d", vid, tid)
+func (s *VideosService) EditTextTrack(vid VideoID, tid int, r *TextTrackRequest, opt ...CallOption) (*TextTrack, *Response, error) {
+	u := fmt.Sprintf("%s/texttracks/%d", vid.path(), tid)
 	req, err := s.client.NewRequest("PATCH", u, r)
 	if err != nil {
 		return nil, nil, err
@@ -114,8 +115,8 @@ func (s *VideosService) EditTextTrack(vid int, tid int, r *TextTrackRequest) (*T
 // DeleteTextTrack method deletes the specified text track from a video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/reference/videos#delete_text_track
-func (s *VideosService) DeleteTextTrack(vid int, tid int) (*Response, error) {
-	u := fmt.Sprintf("videos/%d/texttracks/%d", vid, tid)
+func (s *VideosService) DeleteTextTrack(vid VideoID, tid int, opt ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("%s/texttracks/%d", vid.path(), tid)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,131 @@
+package vimeo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ViewerSync describes the users who should be able to view every private video of a scope.
+// The scope is Videos, and every video returned by List, see UsersService.FolderScope.
+// The users are IDs, URIs or links.
+type ViewerSync struct {
+	Users  []string
+	Videos []int
+	List   ListFunc[Video]
+	// DryRun computes the changes without sending them.
+	DryRun bool
+	// Concurrency limits the number of videos synced at the same time, Config.Concurrency when zero.
+	Concurrency int
+}
+
+// SetViewers method replaces the users who can view the specified private video.
+// The users are IDs, URIs or links. The returned change lists the user URIs added and removed.
+func (s *VideosService) SetViewers(vid int, users []string) (*SyncChange, error) {
+	desired, err := s.resolveUserURIs(users)
+	if err != nil {
+		return nil, err
+	}
+
+	c := s.syncVideoViewers(vid, desired, false)
+	return c, c.Err
+}
+
+// SyncViewers method brings the viewers of every video in the scope to the desired set of users.
+// Videos are synced concurrently; a failed video is reported in its SyncChange and doesn't stop the others.
+// The returned error is the one of resolving the users or listing the scope.
+func (s *VideosService) SyncViewers(vs *ViewerSync) (*SyncReport, error) {
+	desired, err := s.resolveUserURIs(vs.Users)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := scopeIDs(vs.Videos, vs.List)
+	if err != nil {
+		return nil, err
+	}
+
+	n := vs.Concurrency
+	if n < 1 {
+		n = s.client.Config.concurrency()
+	}
+
+	report := syncVideos(ids, n, vs.DryRun, func(vid int) *SyncChange {
+		return s.syncVideoViewers(vid, desired, vs.DryRun)
+	})
+
+	return report, nil
+}
+
+// SyncFolderViewers shortcut brings the viewers of every video in the folder to the desired set of users.
+func (s *VideosService) SyncFolderViewers(folderURI string, users []string, dryRun bool) (*SyncReport, error) {
+	return s.SyncViewers(&ViewerSync{
+		Users:  users,
+		List:   s.client.Users.FolderScope(folderURI),
+		DryRun: dryRun,
+	})
+}
+
+// resolveUserURIs returns the numeric URIs of the users, given as IDs, URIs or links.
+// Users given by their custom URL are looked up, so they compare equal to the URIs of the viewers.
+func (s *VideosService) resolveUserURIs(users []string) ([]string, error) {
+	uris := make([]string, len(users))
+	for i, u := range users {
+		id, err := userID(u)
+		if err != nil {
+			return nil, err
+		}
+		if reNumeric.MatchString(id) {
+			uris[i] = "/users/" + id
+			continue
+		}
+
+		user, _, err := s.client.Users.Get(id, OptFields{"uri"})
+		if err != nil {
+			return nil, fmt.Errorf("resolve user %s: %w", id, err)
+		}
+		uris[i] = user.URI
+	}
+	return uris, nil
+}
+
+// syncVideoViewers brings the viewers of a video to the desired user URIs, as returned by resolveUserURIs.
+func (s *VideosService) syncVideoViewers(vid int, desired []string, dryRun bool) *SyncChange {
+	c := &SyncChange{VideoID: vid}
+
+	viewers, err := NewIterator(func(opt ...CallOption) ([]*User, *Response, error) {
+		return s.ListUser(vid, opt...)
+	}, OptPerPage(maxBatchSize)).All()
+	if err != nil {
+		c.Err = err
+		return c
+	}
+
+	current := make([]string, len(viewers))
+	for i, u := range viewers {
+		current[i] = u.URI
+	}
+	c.Add, c.Remove = diffStrings(current, desired)
+
+	if dryRun {
+		return c
+	}
+
+	for start := 0; start < len(c.Add); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(c.Add) {
+			end = len(c.Add)
+		}
+		if _, _, err := s.AllowUsers(vid, c.Add[start:end]); err != nil {
+			c.Err = fmt.Errorf("allow users: %w", err)
+			return c
+		}
+	}
+	for _, u := range c.Remove {
+		if _, err := s.DisallowUser(vid, strings.TrimPrefix(u, "/users/")); err != nil {
+			c.Err = fmt.Errorf("disallow %s: %w", u, err)
+			return c
+		}
+	}
+
+	return c
+}
@@ -36,13 +36,17 @@ type Client struct {
 	Config *Config
 
 	// Services used for communicating with the API
+	Analytics       *AnalyticsService
 	Categories      *CategoriesService
 	Channels        *ChannelsService
 	ContentRatings  *ContentRatingsService
 	CreativeCommons *CreativeCommonsService
 	Groups          *GroupsService
 	Languages       *LanguagesService
+	LiveEvents      *LiveEventsService
 	Tags            *TagsService
+	Teams           *TeamsService
+	Webinars        *WebinarsService
 	Videos          *VideosService
 	Users           *UsersService
 }
@@ -67,13 +71,17 @@ func NewClient(httpClient *http.Client, config *Config) *Client {
 
 	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: defaultUserAgent}
 	c.Config = config
+	c.Analytics = &AnalyticsService{client: c}
 	c.Categories = &CategoriesService{client: c}
 	c.Channels = &ChannelsService{client: c}
 	c.ContentRatings = &ContentRatingsService{client: c}
 	c.CreativeCommons = &CreativeCommonsService{client: c}
 	c.Groups = &GroupsService{client: c}
 	c.Languages = &LanguagesService{client: c}
+	c.LiveEvents = &LiveEventsService{client: c}
 	c.Tags = &TagsService{client: c}
+	c.Teams = &TeamsService{client: c}
+	c.Webinars = &WebinarsService{client: c}
 	c.Videos = &VideosService{client: c}
 	c.Users = &UsersService{client: c}
 	return c
@@ -43,6 +43,16 @@ func testMethod(t *testing.T, r *http.Request, want string) {
 	}
 }
 
+func testBody(t *testing.T, r *http.Request, want string) {
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatalf("Error reading request body: %v", err)
+	}
+	if got := string(b); got != want {
+		t.Errorf("Request body: %v, want %v", got, want)
+	}
+}
+
 type values map[string]string
 
 func testFormURLValues(t *testing.T, r *http.Request, values values) {
@@ -0,0 +1,252 @@
+package vimeo
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// WebinarsService handles communication with the webinars related
+// methods of the Vimeo API.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/webinars
+type WebinarsService service
+
+// WebinarSchedule internal object provides access to the schedule of the webinar.
+type WebinarSchedule struct {
+	StartTime time.Time `json:"start_time,omitempty"`
+	EndTime   time.Time `json:"end_time,omitempty"`
+}
+
+// WebinarFormField represents a field of the registration form.
+// Type is one of "text", "email", "checkbox" or "select", Options lists the choices of a select.
+type WebinarFormField struct {
+	Name     string   `json:"name,omitempty"`
+	Label    string   `json:"label,omitempty"`
+	Type     string   `json:"type,omitempty"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options,omitempty"`
+}
+
+// WebinarForm internal object provides access to the registration form of the webinar.
+type WebinarForm struct {
+	Active bool                `json:"active"`
+	Fields []*WebinarFormField `json:"fields,omitempty"`
+}
+
+// Webinar represents a webinar.
+type Webinar struct {
+	URI              string            `json:"uri,omitempty"`
+	Title            string            `json:"title,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	Link             string            `json:"link,omitempty"`
+	Status           string            `json:"status,omitempty"`
+	CreatedTime      time.Time         `json:"created_time,omitempty"`
+	Schedule         *WebinarSchedule  `json:"schedule,omitempty"`
+	TimeZone         string            `json:"time_zone,omitempty"`
+	Privacy          *LiveEventPrivacy `json:"privacy,omitempty"`
+	RegistrationForm *WebinarForm      `json:"registration_form,omitempty"`
+	LiveEvent        *LiveEvent        `json:"live_event,omitempty"`
+	User             *User             `json:"user,omitempty"`
+	Metadata         *Metadata         `json:"metadata,omitempty"`
+}
+
+// WebinarRequest represents a request to create/edit a webinar.
+// Nil fields are left out of the request.
+type WebinarRequest struct {
+	Title            *string           `json:"title,omitempty"`
+	Description      *string           `json:"description,omitempty"`
+	Schedule         *WebinarSchedule  `json:"schedule,omitempty"`
+	TimeZone         *string           `json:"time_zone,omitempty"`
+	Privacy          *LiveEventPrivacy `json:"privacy,omitempty"`
+	RegistrationForm *WebinarForm      `json:"registration_form,omitempty"`
+	FolderURI        *string           `json:"folder_uri,omitempty"`
+}
+
+// WebinarRegistrant represents a person registered to a webinar.
+// Fields holds the answers to the custom fields of the registration form.
+type WebinarRegistrant struct {
+	URI         string            `json:"uri,omitempty"`
+	Email       string            `json:"email,omitempty"`
+	FirstName   string            `json:"first_name,omitempty"`
+	LastName    string            `json:"last_name,omitempty"`
+	Status      string            `json:"status,omitempty"`
+	CreatedTime time.Time         `json:"created_time,omitempty"`
+	Fields      map[string]string `json:"fields,omitempty"`
+}
+
+// WebinarRegistrantRequest represents a request to register a person to a webinar.
+type WebinarRegistrantRequest struct {
+	Email     string            `json:"email"`
+	FirstName string            `json:"first_name,omitempty"`
+	LastName  string            `json:"last_name,omitempty"`
+	Fields    map[string]string `json:"fields,omitempty"`
+}
+
+type webinarRegistrantsRequest struct {
+	Registrants []*WebinarRegistrantRequest `json:"registrants"`
+}
+
+// GetID returns the identifier (ID) of the webinar.
+func (w Webinar) GetID() string {
+	l := strings.SplitN(w.URI, "/", -1)
+	return l[len(l)-1]
+}
+
+func webinarsPath(uid string) string {
+	if uid == "" {
+		return "me/webinars"
+	}
+	return fmt.Sprintf("users/%s/webinars", uid)
+}
+
+func webinarPath(uid string, wid string) string {
+	return fmt.Sprintf("%s/%s", webinarsPath(uid), wid)
+}
+
+// List method returns all the webinars that belong to the user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/webinars#get_webinars
+func (s *WebinarsService) List(uid string, opt ...CallOption) ([]*Webinar, *Response, error) {
+	return List[Webinar](s.client, webinarsPath(uid), opt...)
+}
+
+// Create method creates a new webinar.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/webinars#create_webinar
+func (s *WebinarsService) Create(uid string, r *WebinarRequest) (*Webinar, *Response, error) {
+	return Post[Webinar](s.client, webinarsPath(uid), r)
+}
+
+// Get method returns a single webinar.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/webinars#get_webinar
+func (s *WebinarsService) Get(uid string, wid string, opt ...CallOption) (*Webinar, *Response, error) {
+	return Get[Webinar](s.client, webinarPath(uid, wid), opt...)
+}
+
+// Edit method edits the specified webinar.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/webinars#edit_webinar
+func (s *WebinarsService) Edit(uid string, wid string, r *WebinarRequest) (*Webinar, *Response, error) {
+	return Patch[Webinar](s.client, webinarPath(uid, wid), r)
+}
+
+// Delete method deletes the specified webinar.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/webinars#delete_webinar
+func (s *WebinarsService) Delete(uid string, wid string) (*Response, error) {
+	return Delete(s.client, webinarPath(uid, wid))
+}
+
+// SetRegistrationForm shortcut enables the registration form of the webinar with the given fields.
+// Passing the empty string will edit authenticated user.
+func (s *WebinarsService) SetRegistrationForm(uid string, wid string, fields []*WebinarFormField) (*Webinar, *Response, error) {
+	return s.Edit(uid, wid, &WebinarRequest{RegistrationForm: &WebinarForm{Active: true, Fields: fields}})
+}
+
+// GetLiveEvent shortcut returns the live event the webinar is streamed through.
+// Passing the empty string will edit authenticated user.
+func (s *WebinarsService) GetLiveEvent(uid string, wid string, opt ...CallOption) (*LiveEvent, *Response, error) {
+	webinar, resp, err := s.Get(uid, wid)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if webinar.LiveEvent == nil || webinar.LiveEvent.URI == "" {
+		return nil, resp, fmt.Errorf("webinar %s has no live event", wid)
+	}
+
+	return Get[LiveEvent](s.client, strings.TrimPrefix(webinar.LiveEvent.URI, "/"), opt...)
+}
+
+// ListRegistrant method returns the registrants of the webinar.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/webinars#get_webinar_registrants
+func (s *WebinarsService) ListRegistrant(uid string, wid string, opt ...CallOption) ([]*WebinarRegistrant, *Response, error) {
+	return List[WebinarRegistrant](s.client, webinarPath(uid, wid)+"/registrants", opt...)
+}
+
+// AddRegistrants method registers people to the webinar.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/webinars#create_webinar_registrants
+func (s *WebinarsService) AddRegistrants(uid string, wid string, registrants []*WebinarRegistrantRequest) (*Response, error) {
+	return sendNoContent(s.client, "POST", webinarPath(uid, wid)+"/registrants", &webinarRegistrantsRequest{Registrants: registrants})
+}
+
+// AddRegistrant shortcut registers a single person to the webinar.
+// Passing the empty string will edit authenticated user.
+func (s *WebinarsService) AddRegistrant(uid string, wid string, r *WebinarRegistrantRequest) (*Response, error) {
+	return s.AddRegistrants(uid, wid, []*WebinarRegistrantRequest{r})
+}
+
+// ExportRegistrants shortcut writes every registrant of the webinar to w as CSV.
+// The columns are email, first_name, last_name, status and created_time, followed by
+// the custom form fields in alphabetical order. Cells that a spreadsheet would read as
+// a formula (starting with "=", "+", "-", "@", a tab or a carriage return) are prefixed with "'".
+// Passing the empty string will edit authenticated user.
+func (s *WebinarsService) ExportRegistrants(uid string, wid string, w io.Writer) error {
+	registrants, err := NewIterator(func(opt ...CallOption) ([]*WebinarRegistrant, *Response, error) {
+		return s.ListRegistrant(uid, wid, opt...)
+	}, OptPerPage(100)).All()
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	var fields []string
+	for _, r := range registrants {
+		for k := range r.Fields {
+			if !seen[k] {
+				seen[k] = true
+				fields = append(fields, k)
+			}
+		}
+	}
+	sort.Strings(fields)
+
+	cw := csv.NewWriter(w)
+	header := []string{"email", "first_name", "last_name", "status", "created_time"}
+	for _, k := range fields {
+		header = append(header, csvCell(k))
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, r := range registrants {
+		var created string
+		if !r.CreatedTime.IsZero() {
+			created = r.CreatedTime.Format(time.RFC3339)
+		}
+
+		row := []string{csvCell(r.Email), csvCell(r.FirstName), csvCell(r.LastName), csvCell(r.Status), created}
+		for _, k := range fields {
+			row = append(row, csvCell(r.Fields[k]))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvCell escapes a value that a spreadsheet would otherwise evaluate as a formula.
+func csvCell(v string) string {
+	if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
@@ -0,0 +1,202 @@
+package vimeo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestWebinar_GetID(t *testing.T) {
+	w := &Webinar{URI: "/users/1/webinars/abc123"}
+
+	if id := w.GetID(); id != "abc123" {
+		t.Errorf("Webinar.GetID returned %+v, want %+v", id, "abc123")
+	}
+}
+
+func TestWebinarsService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/webinars", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormURLValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"title": "Test"}]}`)
+	})
+
+	webinars, _, err := client.Webinars.List("", OptPage(1), OptPerPage(2))
+	if err != nil {
+		t.Errorf("Webinars.List returned unexpected error: %v", err)
+	}
+
+	want := []*Webinar{{Title: "Test"}}
+	if !reflect.DeepEqual(webinars, want) {
+		t.Errorf("Webinars.List returned %+v, want %+v", webinars, want)
+	}
+}
+
+func TestWebinarsService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &WebinarRequest{
+		Title:    String("Launch"),
+		TimeZone: String("Europe/Paris"),
+	}
+
+	mux.HandleFunc("/users/1/webinars", func(w http.ResponseWriter, r *http.Request) {
+		v := &WebinarRequest{}
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("Webinars.Create returned unexpected error: %v", err)
+		}
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Webinars.Create body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"title": "Launch"}`)
+	})
+
+	webinar, _, err := client.Webinars.Create("1", input)
+	if err != nil {
+		t.Errorf("Webinars.Create returned unexpected error: %v", err)
+	}
+
+	want := &Webinar{Title: "Launch"}
+	if !reflect.DeepEqual(webinar, want) {
+		t.Errorf("Webinars.Create returned %+v, want %+v", webinar, want)
+	}
+}
+
+func TestWebinarsService_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/webinars/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"description":"desc"}`+"\n")
+		fmt.Fprint(w, `{"description": "desc"}`)
+	})
+
+	webinar, _, err := client.Webinars.Edit("", "abc", &WebinarRequest{Description: String("desc")})
+	if err != nil {
+		t.Errorf("Webinars.Edit returned unexpected error: %v", err)
+	}
+
+	want := &Webinar{Description: "desc"}
+	if !reflect.DeepEqual(webinar, want) {
+		t.Errorf("Webinars.Edit returned %+v, want %+v", webinar, want)
+	}
+}
+
+func TestWebinarsService_SetRegistrationForm(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/webinars/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"registration_form":{"active":true,"fields":[{"name":"company","label":"Company","type":"text","required":true}]}}`+"\n")
+		fmt.Fprint(w, `{}`)
+	})
+
+	fields := []*WebinarFormField{{Name: "company", Label: "Company", Type: "text", Required: true}}
+	_, _, err := client.Webinars.SetRegistrationForm("", "abc", fields)
+	if err != nil {
+		t.Errorf("Webinars.SetRegistrationForm returned unexpected error: %v", err)
+	}
+}
+
+func TestWebinarsService_GetLiveEvent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/webinars/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"live_event": {"uri": "/users/1/live_events/2"}}`)
+	})
+	mux.HandleFunc("/users/1/live_events/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"uri": "/users/1/live_events/2", "stream_key": "key"}`)
+	})
+
+	event, _, err := client.Webinars.GetLiveEvent("", "abc")
+	if err != nil {
+		t.Errorf("Webinars.GetLiveEvent returned unexpected error: %v", err)
+	}
+
+	want := &LiveEvent{URI: "/users/1/live_events/2", StreamKey: "key"}
+	if !reflect.DeepEqual(event, want) {
+		t.Errorf("Webinars.GetLiveEvent returned %+v, want %+v", event, want)
+	}
+}
+
+func TestWebinarsService_AddRegistrants(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/webinars/abc/registrants", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"registrants":[{"email":"a@example.com","first_name":"A"}]}`+"\n")
+	})
+
+	_, err := client.Webinars.AddRegistrant("", "abc", &WebinarRegistrantRequest{Email: "a@example.com", FirstName: "A"})
+	if err != nil {
+		t.Errorf("Webinars.AddRegistrant returned unexpected error: %v", err)
+	}
+}
+
+func TestWebinarsService_ExportRegistrants(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/webinars/abc/registrants", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"data": [{"email": "a@example.com", "first_name": "A", "fields": {"company": "Acme"}}],
+				"paging": {"next": "/me/webinars/abc/registrants?page=2"}}`)
+		default:
+			fmt.Fprint(w, `{"data": [{"email": "b@example.com", "created_time": "2024-01-02T03:04:05Z", "fields": {"role": "dev"}}]}`)
+		}
+	})
+
+	var buf bytes.Buffer
+	if err := client.Webinars.ExportRegistrants("", "abc", &buf); err != nil {
+		t.Fatalf("Webinars.ExportRegistrants returned unexpected error: %v", err)
+	}
+
+	want := "email,first_name,last_name,status,created_time,company,role\n" +
+		"a@example.com,A,,,,Acme,\n" +
+		"b@example.com,,,,2024-01-02T03:04:05Z,,dev\n"
+	if buf.String() != want {
+		t.Errorf("Webinars.ExportRegistrants wrote %q, want %q", buf.String(), want)
+	}
+}
+
+func TestWebinarsService_ExportRegistrants_formula(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/webinars/abc/registrants", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": [{"email": "a@example.com", "first_name": "=HYPERLINK(\"x\")", "last_name": "-1+1", "fields": {"@note": "+cmd"}}]}`)
+	})
+
+	var buf bytes.Buffer
+	if err := client.Webinars.ExportRegistrants("", "abc", &buf); err != nil {
+		t.Fatalf("Webinars.ExportRegistrants returned unexpected error: %v", err)
+	}
+
+	want := "email,first_name,last_name,status,created_time,'@note\n" +
+		"a@example.com,\"'=HYPERLINK(\"\"x\"\")\",'-1+1,,,'+cmd\n"
+	if buf.String() != want {
+		t.Errorf("Webinars.ExportRegistrants wrote %q, want %q", buf.String(), want)
+	}
+}